@@ -9,6 +9,13 @@ import (
 	"scheduler/config"
 	"scheduler/internal/app"
 	migrations "scheduler/pkg/migration/postgres"
+	sqlitemigrations "scheduler/pkg/migration/sqlite"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // часовые пояса заданий не зависят от tzdata в образе
+
+	_ "modernc.org/sqlite"
 )
 
 func main() {
@@ -38,20 +45,110 @@ func main() {
 
 	Config := config.NewConfig(connStr, addr)
 
-	db, err := sql.Open("pgx", Config.PgConnStr)
-	if err != nil {
-		logger.Fatal("error with open pgx drivet", zap.Error(err))
+	Config.EngineTick = durationFromEnv(logger, "ENGINE_TICK")
+	Config.LeaseTTL = durationFromEnv(logger, "LEASE_TTL")
+	Config.LeaderCheckInterval = durationFromEnv(logger, "LEADER_CHECK_INTERVAL")
+	Config.DispatchMode = os.Getenv("DISPATCH_MODE")
+	switch Config.DispatchMode {
+	case "":
+		Config.DispatchMode = config.DispatchLeader
+	case config.DispatchLeader, config.DispatchSharded:
+	default:
+		logger.Fatal("invalid DISPATCH_MODE", zap.String("mode", Config.DispatchMode))
 	}
-	defer db.Close()
+	Config.ShardCount = intFromEnv(logger, "SHARD_COUNT")
+	Config.ShardRebalanceInterval = durationFromEnv(logger, "SHARD_REBALANCE_INTERVAL")
+	Config.ExecutorWorkers = intFromEnv(logger, "EXECUTOR_WORKERS")
+	Config.HTTPMaxBody = intFromEnv(logger, "HTTP_JOB_MAX_BODY")
+	Config.HTTPTimeout = durationFromEnv(logger, "HTTP_JOB_TIMEOUT")
+	// Задания exec запускают команды на хосте планировщика, поэтому по умолчанию выключены
+	Config.ExecCommands = listFromEnv("EXEC_ALLOWED_COMMANDS")
+	Config.ExecTimeout = durationFromEnv(logger, "EXEC_TIMEOUT")
+	Config.ExecMaxOutput = intFromEnv(logger, "EXEC_MAX_OUTPUT")
+	Config.NotifierInterval = durationFromEnv(logger, "NOTIFIER_INTERVAL")
 
-	// применяем миграции
-	if err := migrations.Migrate(db); err != nil {
-		logger.Fatal("error with create migrations", zap.Error(err))
+	Config.StorageBackend = os.Getenv("STORAGE_BACKEND")
+	switch Config.StorageBackend {
+	case "":
+		Config.StorageBackend = config.StoragePostgres
+	case config.StoragePostgres, config.StorageMemory:
+	case config.StorageSQLite:
+		Config.SQLitePath = os.Getenv("SQLITE_PATH")
+		if Config.SQLitePath == "" {
+			Config.SQLitePath = "scheduler.db"
+		}
+	default:
+		logger.Fatal("invalid STORAGE_BACKEND", zap.String("backend", Config.StorageBackend))
 	}
 
-	logger.Info("Migrations applied successfully")
+	// Хранилищу в памяти миграции не нужны
+	switch Config.StorageBackend {
+	case config.StoragePostgres:
+		db, err := sql.Open("pgx", Config.PgConnStr)
+		if err != nil {
+			logger.Fatal("error with open pgx drivet", zap.Error(err))
+		}
+		defer db.Close()
+
+		// применяем миграции
+		if err := migrations.Migrate(db); err != nil {
+			logger.Fatal("error with create migrations", zap.Error(err))
+		}
+
+		logger.Info("Migrations applied successfully")
+	case config.StorageSQLite:
+		db, err := sql.Open("sqlite", Config.SQLitePath)
+		if err != nil {
+			logger.Fatal("error with open sqlite driver", zap.Error(err))
+		}
+		defer db.Close()
+
+		// у SQLite свой набор миграций
+		if err := sqlitemigrations.Migrate(db); err != nil {
+			logger.Fatal("error with create migrations", zap.Error(err))
+		}
+
+		logger.Info("Migrations applied successfully", zap.String("sqlite_path", Config.SQLitePath))
+	}
 
 	if err := app.Start(Config, logger); err != nil {
 		panic(err)
 	}
 }
+
+// durationFromEnv читает необязательную длительность; 0 означает значение по умолчанию
+func durationFromEnv(logger *zap.Logger, name string) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		logger.Fatal("invalid "+name, zap.Error(err))
+	}
+	return d
+}
+
+// intFromEnv читает необязательное целое число; 0 означает значение по умолчанию
+func intFromEnv(logger *zap.Logger, name string) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		logger.Fatal("invalid "+name, zap.Error(err))
+	}
+	return n
+}
+
+// listFromEnv читает необязательный список значений через запятую
+func listFromEnv(name string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(name), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -1,8 +1,49 @@
 package config
 
+import "time"
+
+const (
+	// DispatchLeader lets a single elected replica dispatch all jobs.
+	DispatchLeader = "leader"
+	// DispatchSharded splits the jobs between all replicas by shards.
+	DispatchSharded = "sharded"
+
+	// StoragePostgres keeps the data in Postgres, shared by all replicas.
+	StoragePostgres = "postgres"
+	// StorageMemory keeps the data in the memory of a single process, for tests and development.
+	StorageMemory = "memory"
+	// StorageSQLite keeps the data in an SQLite file of a single process, for edge and single-node deployments.
+	StorageSQLite = "sqlite"
+)
+
 type Config struct {
-	PgConnStr string
-	Addr      string
+	// StorageBackend is StoragePostgres, the default, StorageSQLite in the SQLitePath file or StorageMemory
+	StorageBackend string
+	PgConnStr      string
+	SQLitePath     string
+	Addr           string
+	EngineTick     time.Duration
+	LeaseTTL       time.Duration
+	// LeaderCheckInterval is how often replicas try to become the leader and the leader checks its connection
+	LeaderCheckInterval time.Duration
+	// DispatchMode is DispatchLeader, the default, or DispatchSharded
+	DispatchMode string
+	// ShardCount must be the same for all replicas in the sharded mode
+	ShardCount             int
+	ShardRebalanceInterval time.Duration
+	// ExecutorWorkers bounds the jobs the engine runs in-process at a time, such as HTTP jobs
+	ExecutorWorkers int
+	// HTTPMaxBody is how many bytes of the response body of an HTTP job are kept in the execution output
+	HTTPMaxBody int
+	// HTTPTimeout bounds the requests of the HTTP jobs without a timeout of their own
+	HTTPTimeout time.Duration
+	// ExecCommands is the allowlist of the commands exec jobs may run, exec jobs are disabled when it is empty
+	ExecCommands []string
+	// ExecTimeout and ExecMaxOutput bound every command, jobs may only lower them
+	ExecTimeout   time.Duration
+	ExecMaxOutput int
+	// NotifierInterval is how often every replica delivers the due notifications of jobs
+	NotifierInterval time.Duration
 }
 
 func NewConfig(pgConnStr string, addr string) *Config {
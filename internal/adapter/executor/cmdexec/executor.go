@@ -0,0 +1,162 @@
+package cmdexec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"scheduler/internal/cases"
+	"scheduler/internal/entity"
+	"time"
+)
+
+var _ cases.Executor = (*Executor)(nil)
+
+const (
+	defaultTimeout   = 10 * time.Minute
+	defaultMaxOutput = 64 << 10
+
+	// waitDelay is how long the output pipes may stay open after the command is killed,
+	// e.g. by its own child processes
+	waitDelay = time.Second
+
+	// ErrorClassExitCode is the error class of commands which exited with a non-zero code.
+	ErrorClassExitCode = "exit_code"
+	// ErrorClassStart is the error class of commands which could not be started.
+	ErrorClassStart = "exec_start"
+)
+
+// Executor runs entity.JobTypeExec jobs on the scheduler host.
+//
+// Only the allowlisted commands run, with the environment given in the payload alone,
+// so that the secrets of the scheduler do not leak into them. The command is killed
+// once its timeout or the job timeout expires, whichever is shorter, and stdout and
+// stderr are each kept up to the output limit.
+type Executor struct {
+	allowed   []string
+	timeout   time.Duration
+	maxOutput int
+}
+
+// NewExecutor returns an executor of the allowed commands with the upper bounds of the timeout
+// and of the output size of a single command.
+func NewExecutor(allowed []string, timeout time.Duration, maxOutput int) *Executor {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	if maxOutput <= 0 {
+		maxOutput = defaultMaxOutput
+	}
+	return &Executor{
+		allowed:   allowed,
+		timeout:   timeout,
+		maxOutput: maxOutput,
+	}
+}
+
+// Result is stored as the JSON output of the execution.
+type Result struct {
+	ExitCode   int    `json:"exitCode"`
+	DurationMs int64  `json:"durationMs"`
+	Stdout     string `json:"stdout,omitempty"`
+	Stderr     string `json:"stderr,omitempty"`
+	Truncated  bool   `json:"truncated,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Error is a failed exec job, its class is matched against the retry policy of the job.
+type Error struct {
+	class string
+	msg   string
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+func (e *Error) ErrorClass() string {
+	return e.class
+}
+
+func (x *Executor) Execute(ctx context.Context, job entity.Job) (string, error) {
+	// Список разрешенных команд проверяется и при запуске: он мог измениться после создания задания
+	spec, errs := entity.ParseExecRequest(job.Payload, x.allowed)
+	if len(errs) > 0 {
+		return "", fmt.Errorf("invalid exec job %s: %s: %s", job.ID, errs[0].Field, errs[0].Reason)
+	}
+
+	timeout, maxOutput := x.timeout, x.maxOutput
+	if spec.Timeout != "" {
+		d, _ := time.ParseDuration(spec.Timeout) // checked by ParseExecRequest
+		timeout = min(timeout, d)
+	}
+	if spec.MaxOutput > 0 {
+		maxOutput = min(maxOutput, spec.MaxOutput)
+	}
+	cmdCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(cmdCtx, spec.Command, spec.Args...)
+	cmd.Dir = spec.Dir
+	cmd.Env = make([]string, 0, len(spec.Env))
+	for k, v := range spec.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.WaitDelay = waitDelay
+	stdout := &limitedBuffer{limit: maxOutput}
+	stderr := &limitedBuffer{limit: maxOutput}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	start := time.Now()
+	err := cmd.Run()
+	res := Result{
+		ExitCode:   cmd.ProcessState.ExitCode(),
+		DurationMs: time.Since(start).Milliseconds(),
+		Stdout:     string(stdout.buf),
+		Stderr:     string(stderr.buf),
+		Truncated:  stdout.truncated || stderr.truncated,
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return res.String(), nil
+	case ctx.Err() != nil:
+		// Таймаут задания и отмену выполнения движок распознает по своему контексту
+		res.Error = "killed: " + ctx.Err().Error()
+		return res.String(), fmt.Errorf("command %s killed:%w", spec.Command, ctx.Err())
+	case cmdCtx.Err() != nil:
+		res.Error = "killed: " + cmdCtx.Err().Error()
+		return res.String(), &Error{class: cases.ReasonTimeout, msg: fmt.Sprintf("command %s timed out after %s", spec.Command, timeout)}
+	case errors.As(err, &exitErr):
+		return res.String(), &Error{class: ErrorClassExitCode, msg: fmt.Sprintf("command %s exited with code %d", spec.Command, res.ExitCode)}
+	default:
+		res.Error = err.Error()
+		return res.String(), &Error{class: ErrorClassStart, msg: err.Error()}
+	}
+}
+
+func (r Result) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// limitedBuffer keeps the first limit bytes written to it and drops the rest.
+type limitedBuffer struct {
+	buf       []byte
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if free := b.limit - len(b.buf); len(p) > free {
+		b.buf = append(b.buf, p[:free]...)
+		b.truncated = true
+	} else {
+		b.buf = append(b.buf, p...)
+	}
+	// Лишний вывод отбрасывается, а не прерывает команду
+	return len(p), nil
+}
@@ -0,0 +1,118 @@
+package cmdexec_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"scheduler/internal/adapter/executor/cmdexec"
+	"scheduler/internal/cases"
+	"scheduler/internal/entity"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExecuteDisabledWithoutAllowlist(t *testing.T) {
+	x := cmdexec.NewExecutor(nil, 0, 0)
+	output, err := x.Execute(context.Background(), execJob("/bin/echo", "hi"))
+	if err == nil || output != "" {
+		t.Errorf("exec without an allowlist: output %q, error %v; want nothing run", output, err)
+	}
+}
+
+func TestExecuteRejectsCommandNotAllowed(t *testing.T) {
+	// Список проверяется при запуске, так что подмена команды в payload выполнения не проходит
+	x := cmdexec.NewExecutor([]string{"/bin/echo"}, 0, 0)
+	for _, command := range []string{"/bin/sh", "echo", "/bin/echo/../sh"} {
+		output, err := x.Execute(context.Background(), execJob(command, "-c", "echo pwned"))
+		if err == nil || output != "" {
+			t.Errorf("exec of %s: output %q, error %v; want nothing run", command, output, err)
+		}
+	}
+}
+
+func TestExecuteDoesNotInheritEnvironment(t *testing.T) {
+	t.Setenv("SCHEDULER_TEST_SECRET", "s3cret")
+	x := cmdexec.NewExecutor([]string{"/usr/bin/env"}, 0, 0)
+	job := execJob("/usr/bin/env")
+	job.Payload["env"] = map[string]any{"TARGET": "s3"}
+
+	res := run(t, x, job, nil)
+	if res.Stdout != "TARGET=s3\n" {
+		t.Errorf("environment of the command = %q, want only the payload env", res.Stdout)
+	}
+}
+
+func TestExecuteTruncatesOutput(t *testing.T) {
+	x := cmdexec.NewExecutor([]string{"/bin/sh"}, 0, 100)
+	res := run(t, x, execJob("/bin/sh", "-c", "head -c 10000 /dev/zero; head -c 10000 /dev/zero >&2"), nil)
+	if len(res.Stdout) != 100 || len(res.Stderr) != 100 || !res.Truncated {
+		t.Errorf("output of %d and %d bytes, truncated %v; want 100 bytes each, truncated", len(res.Stdout), len(res.Stderr), res.Truncated)
+	}
+
+	// Задание может только уменьшить лимит
+	job := execJob("/bin/sh", "-c", "head -c 10000 /dev/zero")
+	job.Payload["maxOutput"] = 1000
+	if res := run(t, x, job, nil); len(res.Stdout) != 100 {
+		t.Errorf("output of %d bytes with a larger job limit, want 100", len(res.Stdout))
+	}
+}
+
+func TestExecuteKillsOnTimeout(t *testing.T) {
+	x := cmdexec.NewExecutor([]string{"/bin/sleep"}, time.Minute, 0)
+	job := execJob("/bin/sleep", "30")
+	job.Payload["timeout"] = "100ms"
+
+	start := time.Now()
+	res := run(t, x, job, func(err error) {
+		var classified cases.ClassifiedError
+		if !errors.As(err, &classified) || classified.ErrorClass() != cases.ReasonTimeout {
+			t.Errorf("error %v, want class %s", err, cases.ReasonTimeout)
+		}
+	})
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("command killed after %v", elapsed)
+	}
+	if !strings.HasPrefix(res.Error, "killed") {
+		t.Errorf("result error = %q, want killed", res.Error)
+	}
+}
+
+func TestExecuteExitCode(t *testing.T) {
+	x := cmdexec.NewExecutor([]string{"/bin/sh"}, 0, 0)
+	res := run(t, x, execJob("/bin/sh", "-c", "echo out; echo err >&2; exit 3"), func(err error) {
+		var classified cases.ClassifiedError
+		if !errors.As(err, &classified) || classified.ErrorClass() != cmdexec.ErrorClassExitCode {
+			t.Errorf("error %v, want class %s", err, cmdexec.ErrorClassExitCode)
+		}
+	})
+	if res.ExitCode != 3 || res.Stdout != "out\n" || res.Stderr != "err\n" {
+		t.Errorf("result = %+v", res)
+	}
+}
+
+func execJob(command string, args ...string) entity.Job {
+	payload := map[string]any{"command": command}
+	if len(args) > 0 {
+		payload["args"] = args
+	}
+	return entity.Job{ID: "job", Type: entity.JobTypeExec, Payload: payload}
+}
+
+// run executes the job and decodes its result. checkErr checks the error of a failed command,
+// without it the command must succeed.
+func run(t *testing.T, x *cmdexec.Executor, job entity.Job, checkErr func(error)) cmdexec.Result {
+	t.Helper()
+	output, err := x.Execute(context.Background(), job)
+	switch {
+	case checkErr != nil:
+		checkErr(err)
+	case err != nil:
+		t.Fatalf("execute: %v", err)
+	}
+	var res cmdexec.Result
+	if err := json.Unmarshal([]byte(output), &res); err != nil {
+		t.Fatalf("output %q: %v", output, err)
+	}
+	return res
+}
@@ -0,0 +1,172 @@
+package httpexec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"scheduler/internal/cases"
+	"scheduler/internal/entity"
+	"time"
+	"unicode/utf8"
+)
+
+var _ cases.Executor = (*Executor)(nil)
+
+const (
+	defaultMaxBody = 4 << 10
+	defaultTimeout = 30 * time.Second
+
+	// ErrorClassRequest is the error class of requests which got no response.
+	ErrorClassRequest = "http_request"
+	// ErrorClassStatus is the error class of responses with an unexpected status.
+	ErrorClassStatus = "http_status"
+)
+
+// Executor runs entity.JobTypeHTTP jobs: it sends the request described by the job payload
+// and fails the execution unless the response status is expected.
+// The job timeout bounds the whole request, a job without one is bounded by the timeout
+// of the executor, so that an endpoint which never answers can't hold a worker forever.
+type Executor struct {
+	client  *http.Client
+	maxBody int
+	timeout time.Duration
+}
+
+// NewExecutor returns an executor which keeps up to maxBody bytes of the response body in the output
+// and bounds the requests of the jobs without a timeout by timeout.
+func NewExecutor(client *http.Client, maxBody int, timeout time.Duration) *Executor {
+	if client == nil {
+		client = &http.Client{}
+	}
+	if maxBody <= 0 {
+		maxBody = defaultMaxBody
+	}
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Executor{
+		client:  client,
+		maxBody: maxBody,
+		timeout: timeout,
+	}
+}
+
+// Result is stored as the JSON output of the execution.
+type Result struct {
+	Status    int    `json:"status,omitempty"`
+	LatencyMs int64  `json:"latencyMs"`
+	Body      string `json:"body,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Error is a failed HTTP job, its class is matched against the retry policy of the job.
+type Error struct {
+	class string
+	msg   string
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+func (e *Error) ErrorClass() string {
+	return e.class
+}
+
+func (x *Executor) Execute(ctx context.Context, job entity.Job) (string, error) {
+	spec, errs := entity.ParseHTTPRequest(job.Payload)
+	if len(errs) > 0 {
+		return "", fmt.Errorf("invalid HTTP job %s: %s: %s", job.ID, errs[0].Field, errs[0].Reason)
+	}
+
+	// Таймаут задания уже в контексте, остальные запросы ограничиваем таймаутом по умолчанию
+	if job.Timeout == "" {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, x.timeout)
+		defer cancel()
+	}
+
+	req, err := newRequest(ctx, spec)
+	if err != nil {
+		return "", fmt.Errorf("build HTTP request error:%w", err)
+	}
+
+	start := time.Now()
+	resp, err := x.client.Do(req)
+	if err != nil {
+		res := Result{LatencyMs: time.Since(start).Milliseconds(), Error: err.Error()}
+		// Истекший таймаут задания распознает движок по контексту
+		return res.String(), &Error{class: ErrorClassRequest, msg: err.Error()}
+	}
+	defer resp.Body.Close()
+
+	// Тело читается на байт больше лимита, чтобы узнать, обрезано ли оно
+	body, err := io.ReadAll(io.LimitReader(resp.Body, int64(x.maxBody)+1))
+	res := Result{
+		Status:    resp.StatusCode,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if len(body) > x.maxBody {
+		body = trimCutRune(body[:x.maxBody])
+		res.Truncated = true
+	}
+	res.Body = string(body)
+	if err != nil {
+		res.Error = err.Error()
+		return res.String(), &Error{class: ErrorClassRequest, msg: "read response body: " + err.Error()}
+	}
+
+	if !spec.Expects(resp.StatusCode) {
+		return res.String(), &Error{class: ErrorClassStatus, msg: fmt.Sprintf("unexpected status %d", resp.StatusCode)}
+	}
+	return res.String(), nil
+}
+
+func (r Result) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+func newRequest(ctx context.Context, spec entity.HTTPRequest) (*http.Request, error) {
+	var body io.Reader
+	contentType := ""
+	switch b := spec.Body.(type) {
+	case nil:
+	case string:
+		body = bytes.NewBufferString(b)
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, spec.Method, spec.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range spec.Headers {
+		req.Header.Set(k, v)
+	}
+	return req, nil
+}
+
+// trimCutRune drops the tail of a rune cut in half by the size limit.
+func trimCutRune(body []byte) []byte {
+	for i := 0; i < utf8.UTFMax-1 && len(body) > 0; i++ {
+		if r, _ := utf8.DecodeLastRune(body); r != utf8.RuneError {
+			break
+		}
+		body = body[:len(body)-1]
+	}
+	return body
+}
@@ -0,0 +1,140 @@
+package httpexec_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"scheduler/internal/adapter/executor/httpexec"
+	"scheduler/internal/cases"
+	"scheduler/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestExecuteStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var status int
+		if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	x := httpexec.NewExecutor(srv.Client(), 0, 0)
+	for _, tc := range []struct {
+		name     string
+		status   int
+		expected []any
+		ok       bool
+	}{
+		{"2xx by default", http.StatusNoContent, nil, true},
+		{"5xx by default", http.StatusInternalServerError, nil, false},
+		{"expected 404", http.StatusNotFound, []any{404}, true},
+		{"unexpected 200", http.StatusOK, []any{202}, false},
+	} {
+		payload := map[string]any{"method": "POST", "url": srv.URL, "body": tc.status}
+		if tc.expected != nil {
+			payload["expectedStatus"] = tc.expected
+		}
+		output, err := x.Execute(context.Background(), entity.Job{ID: "job", Payload: payload})
+
+		var res httpexec.Result
+		if err := json.Unmarshal([]byte(output), &res); err != nil {
+			t.Fatalf("%s: output %q: %v", tc.name, output, err)
+		}
+		if res.Status != tc.status {
+			t.Errorf("%s: status = %d, want %d", tc.name, res.Status, tc.status)
+		}
+		if tc.ok {
+			if err != nil {
+				t.Errorf("%s: %v", tc.name, err)
+			}
+			continue
+		}
+		var classified cases.ClassifiedError
+		if !errors.As(err, &classified) || classified.ErrorClass() != httpexec.ErrorClassStatus {
+			t.Errorf("%s: error %v, want class %s", tc.name, err, httpexec.ErrorClassStatus)
+		}
+	}
+}
+
+func TestExecuteTruncatesBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// "ж" занимает два байта, лимиты в 4 и 6 байт режут букву пополам
+		w.Write([]byte("abcжж"))
+	}))
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		maxBody   int
+		body      string
+		truncated bool
+	}{
+		{4, "abc", true},
+		{5, "abcж", true},
+		{6, "abcж", true},
+		{7, "abcжж", false},
+	} {
+		x := httpexec.NewExecutor(srv.Client(), tc.maxBody, 0)
+		output, err := x.Execute(context.Background(), entity.Job{ID: "job", Payload: map[string]any{"url": srv.URL}})
+		if err != nil {
+			t.Fatalf("max body %d: %v", tc.maxBody, err)
+		}
+		var res httpexec.Result
+		if err := json.Unmarshal([]byte(output), &res); err != nil {
+			t.Fatalf("max body %d: output %q: %v", tc.maxBody, output, err)
+		}
+		if res.Body != tc.body || res.Truncated != tc.truncated {
+			t.Errorf("max body %d: body %q, truncated %v; want %q, %v", tc.maxBody, res.Body, res.Truncated, tc.body, tc.truncated)
+		}
+	}
+}
+
+func TestExecuteRequestError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	x := httpexec.NewExecutor(nil, 0, 0)
+	_, err := x.Execute(context.Background(), entity.Job{ID: "job", Payload: map[string]any{"url": url}})
+	var classified cases.ClassifiedError
+	if !errors.As(err, &classified) || classified.ErrorClass() != httpexec.ErrorClassRequest {
+		t.Errorf("error %v, want class %s", err, httpexec.ErrorClassRequest)
+	}
+}
+
+func TestExecuteDefaultTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	// Задание без таймаута не ждет молчащий сервер дольше таймаута исполнителя
+	x := httpexec.NewExecutor(srv.Client(), 0, 50*time.Millisecond)
+	start := time.Now()
+	_, err := x.Execute(context.Background(), entity.Job{ID: "job", Payload: map[string]any{"url": srv.URL}})
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("request took %v", elapsed)
+	}
+	var classified cases.ClassifiedError
+	if !errors.As(err, &classified) || classified.ErrorClass() != httpexec.ErrorClassRequest {
+		t.Errorf("error %v, want class %s", err, httpexec.ErrorClassRequest)
+	}
+
+	// Таймаут задания уже ограничивает контекст, исполнитель свой не добавляет
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	x.Execute(ctx, entity.Job{ID: "job", Timeout: "300ms", Payload: map[string]any{"url": srv.URL}})
+	if elapsed := time.Since(start); elapsed < 250*time.Millisecond {
+		t.Errorf("job with a timeout was cut after %v by the default timeout", elapsed)
+	}
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"scheduler/internal/cases"
+	"scheduler/internal/port/repo"
+	"time"
+)
+
+var _ cases.NotificationSender = (*Sender)(nil)
+
+const (
+	defaultTimeout = 10 * time.Second
+
+	// SignatureHeader carries "sha256=" followed by the hex HMAC-SHA256 of the body keyed by the secret of the webhook.
+	SignatureHeader = "X-Scheduler-Signature"
+	EventHeader     = "X-Scheduler-Event"
+	// DeliveryHeader identifies the delivery, it is the same for all attempts of it.
+	DeliveryHeader = "X-Scheduler-Delivery"
+)
+
+// Sender posts notifications to webhooks with signed bodies.
+type Sender struct {
+	client *http.Client
+}
+
+func NewSender(client *http.Client) *Sender {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Sender{client: client}
+}
+
+func (s *Sender) Send(ctx context.Context, delivery *repo.NotificationDTO) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, delivery.Body))
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Тело дочитывается, чтобы соединение вернулось в пул
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the value of SignatureHeader for the body, receivers compare it in constant time.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
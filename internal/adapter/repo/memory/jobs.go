@@ -0,0 +1,818 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"scheduler/internal/cases"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var _ cases.JobsRepo = (*JobsRepo)(nil)
+
+// JobsRepo keeps jobs and everything about them in the memory of the process.
+//
+// It follows the semantics of the Postgres repository, including its conflicts and
+// cascading deletes, so it serves tests and single-node development runs. Every method
+// holds a single lock, which makes the multi-step updates atomic the way transactions
+// make them in Postgres. Values are copied in and out, callers never share them with the store.
+type JobsRepo struct {
+	mu sync.Mutex
+
+	jobs          map[string]*repo.JobDTO
+	executions    map[string]*repo.ExecutionDTO
+	deadLetters   map[string]*repo.DeadLetterDTO
+	workflows     map[string]*repo.WorkflowDTO
+	workflowRuns  map[string]*repo.WorkflowRunDTO
+	logs          map[string][]repo.ExecutionLogDTO // by execution ID, in order of Seq
+	logSeq        int64
+	notifications map[string]*repo.NotificationDTO
+}
+
+func NewJobsRepo() *JobsRepo {
+	return &JobsRepo{
+		jobs:          make(map[string]*repo.JobDTO),
+		executions:    make(map[string]*repo.ExecutionDTO),
+		deadLetters:   make(map[string]*repo.DeadLetterDTO),
+		workflows:     make(map[string]*repo.WorkflowDTO),
+		workflowRuns:  make(map[string]*repo.WorkflowRunDTO),
+		logs:          make(map[string][]repo.ExecutionLogDTO),
+		notifications: make(map[string]*repo.NotificationDTO),
+	}
+}
+
+func (r *JobsRepo) Create(ctx context.Context, job *repo.JobDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, err := r.newJob(job)
+	if err != nil {
+		return err
+	}
+	r.jobs[stored.ID] = stored
+	return nil
+}
+
+func (r *JobsRepo) Read(ctx context.Context, jobID string) (*repo.JobDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[jobID]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	return copyJob(job), nil
+}
+
+// Delete removes the job together with its executions, dead letters and notifications.
+func (r *JobsRepo) Delete(ctx context.Context, jobID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.jobs[jobID]; !ok {
+		return repo.ErrNotFound
+	}
+	r.deleteJob(jobID)
+	return nil
+}
+
+// List returns up to query.Limit jobs after query.After in the order of the sort column and then of the ID.
+func (r *JobsRepo) List(ctx context.Context, query *repo.JobsQueryDTO) ([]repo.JobDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Позиция задания относительно курсора с учетом направления сортировки
+	position := func(job *repo.JobDTO, key int64, id string) int {
+		c := compare(job.SortKey(query.Sort), key, job.ID, id)
+		if query.Desc {
+			return -c
+		}
+		return c
+	}
+
+	var matched []*repo.JobDTO
+	for _, job := range r.jobs {
+		switch {
+		case query.Status != nil && string(job.Status) != *query.Status,
+			!hasSchedule(job, query.Schedule),
+			!hasLabels(job, query.Labels),
+			query.CreatedAfter != nil && job.CreatedAt < *query.CreatedAfter,
+			query.CreatedBefore != nil && job.CreatedAt >= *query.CreatedBefore,
+			query.After != nil && position(job, query.After.Key, query.After.ID) <= 0:
+			continue
+		}
+		matched = append(matched, job)
+	}
+	slices.SortFunc(matched, func(a, b *repo.JobDTO) int {
+		return position(a, b.SortKey(query.Sort), b.ID)
+	})
+
+	var jobs []repo.JobDTO
+	for _, job := range limited(matched, query.Limit) {
+		jobs = append(jobs, *copyJob(job))
+	}
+	return jobs, nil
+}
+
+// ListExecutions returns up to query.Limit executions of the job after query.After
+// in the order of the start time and then of the ID.
+func (r *JobsRepo) ListExecutions(ctx context.Context, jobID string, query *repo.ExecutionsQueryDTO) ([]repo.ExecutionDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Позиция выполнения относительно курсора с учетом направления сортировки
+	position := func(e *repo.ExecutionDTO, key int64, id string) int {
+		c := compare(e.SortKey(), key, e.ID, id)
+		if query.Desc {
+			return -c
+		}
+		return c
+	}
+
+	var matched []*repo.ExecutionDTO
+	for _, e := range r.executions {
+		switch {
+		case e.JobID != jobID,
+			query.WorkerID != nil && (e.WorkerID == "" || e.WorkerID != *query.WorkerID),
+			query.Status != nil && e.Status != *query.Status,
+			query.StartedAfter != nil && (e.StartedAt == 0 || e.StartedAt < *query.StartedAfter),
+			query.StartedBefore != nil && (e.StartedAt == 0 || e.StartedAt >= *query.StartedBefore),
+			query.After != nil && position(e, query.After.Key, query.After.ID) <= 0:
+			continue
+		}
+		matched = append(matched, e)
+	}
+	slices.SortFunc(matched, func(a, b *repo.ExecutionDTO) int {
+		return position(a, b.SortKey(), b.ID)
+	})
+
+	var execs []repo.ExecutionDTO
+	for _, e := range limited(matched, query.Limit) {
+		execs = append(execs, *copyExecution(e))
+	}
+	return execs, nil
+}
+
+// ListDue returns jobs whose next run time is not after now and which are not paused.
+// Running jobs are returned too, their concurrency policy decides about the run.
+// Non-nil shards restricts the jobs to the owned shards.
+func (r *JobsRepo) ListDue(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.JobDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []*repo.JobDTO
+	for _, job := range r.jobs {
+		if job.NextRunAt != nil && *job.NextRunAt <= now && job.Status != repo.Paused && owns(shards, job.ID) {
+			due = append(due, job)
+		}
+	}
+	slices.SortFunc(due, func(a, b *repo.JobDTO) int {
+		return compare(*a.NextRunAt, *b.NextRunAt, a.ID, b.ID)
+	})
+
+	jobs := make([]repo.JobDTO, 0, min(uint64(len(due)), limit))
+	for _, job := range limited(due, limit) {
+		jobs = append(jobs, *copyJob(job))
+	}
+	return jobs, nil
+}
+
+// StartExecution marks the job as running and records the execution together with the skipped ones.
+// An execution without a worker is queued until a worker leases it.
+// For a scheduled run dueAt is the next run time of the job, which is moved to nextRunAt;
+// a manual run with nil dueAt does not move it.
+// Active executions of a job with the replace policy are cancelled, their IDs are returned.
+// It returns repo.ErrNotFound if there is no such job and repo.ErrConflict if the job is paused,
+// has been fired meanwhile or is running and forbids concurrent runs.
+func (r *JobsRepo) StartExecution(ctx context.Context, exec *repo.ExecutionDTO, dueAt *int64, nextRunAt *int64, skipped []repo.ExecutionDTO) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[exec.JobID]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	if job.Status == repo.Paused ||
+		(dueAt != nil && (job.NextRunAt == nil || *job.NextRunAt != *dueAt)) ||
+		(job.Status == repo.Running && job.ConcurrencyPolicy == string(entity.ConcurrencyForbid)) {
+		return nil, repo.ErrConflict
+	}
+	inserted, err := r.newExecutions(append(slices.Clone(skipped), *exec)...)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Status = repo.Running
+	if dueAt != nil {
+		job.NextRunAt = copyPtr(nextRunAt)
+	}
+
+	var replaced []string
+	if job.ConcurrencyPolicy == string(entity.ConcurrencyReplace) {
+		for _, e := range r.sortedExecutions() {
+			if e.JobID == job.ID && isActive(e) {
+				e.Status = string(repo.Cancelled)
+				e.Reason = cases.ReasonReplaced
+				e.FinishedAt = exec.ScheduledAt
+				replaced = append(replaced, e.ID)
+			}
+		}
+	}
+
+	r.insertExecutions(inserted)
+	return replaced, nil
+}
+
+// SkipFires moves the next run time of the job due at dueAt without running it
+// and records the skipped executions.
+// It returns repo.ErrConflict if the job has been fired, paused or skipped meanwhile.
+func (r *JobsRepo) SkipFires(ctx context.Context, jobID string, dueAt int64, nextRunAt *int64, skipped []repo.ExecutionDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[jobID]
+	if !ok || job.NextRunAt == nil || *job.NextRunAt != dueAt || job.Status == repo.Paused {
+		return repo.ErrConflict
+	}
+	inserted, err := r.newExecutions(skipped...)
+	if err != nil {
+		return err
+	}
+
+	job.NextRunAt = copyPtr(nextRunAt)
+	r.insertExecutions(inserted)
+	return nil
+}
+
+// FinishExecution stores the final status of the execution held by exec.WorkerID.
+// Without retry the job gets the same status, otherwise retry is queued and the job stays running.
+// A non-nil deadLetter is recorded for the job and notifications are queued along with it.
+// It returns repo.ErrConflict if the execution is not running or is held by another worker.
+func (r *JobsRepo) FinishExecution(ctx context.Context, exec *repo.ExecutionDTO, retry *repo.ExecutionDTO, deadLetter *repo.DeadLetterDTO, notifications []repo.NotificationDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.executions[exec.ID]
+	if !ok {
+		return repo.ErrNotFound
+	}
+	if !heldBy(stored, exec.WorkerID) {
+		return repo.ErrConflict
+	}
+	exec.JobID = stored.JobID
+
+	var inserted []*repo.ExecutionDTO
+	if retry != nil {
+		retry.JobID = exec.JobID
+		var err error
+		if inserted, err = r.newExecutions(*retry); err != nil {
+			return err
+		}
+	}
+	if deadLetter != nil {
+		if _, ok := r.deadLetters[deadLetter.ID]; ok {
+			return fmt.Errorf("dead letter %s already exists", deadLetter.ID)
+		}
+	}
+	for i := range notifications {
+		if _, ok := r.notifications[notifications[i].ID]; ok {
+			return fmt.Errorf("notification %s already exists", notifications[i].ID)
+		}
+	}
+
+	stored.Status = exec.Status
+	stored.FinishedAt = exec.FinishedAt
+	stored.Output = exec.Output
+	stored.Reason = exec.Reason
+	stored.Error = exec.Error
+
+	if retry != nil {
+		r.insertExecutions(inserted)
+	} else {
+		r.finishJob(exec.JobID, exec.Status, exec.FinishedAt)
+	}
+
+	if deadLetter != nil {
+		deadLetter.JobID = exec.JobID
+		stored := *deadLetter
+		r.deadLetters[stored.ID] = &stored
+	}
+	for i := range notifications {
+		notifications[i].JobID = exec.JobID
+		r.notifications[notifications[i].ID] = copyNotification(&notifications[i])
+	}
+	return nil
+}
+
+// ReadExecution returns the execution by its ID.
+func (r *JobsRepo) ReadExecution(ctx context.Context, execID string) (*repo.ExecutionDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.executions[execID]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	return copyExecution(e), nil
+}
+
+// LeaseExecutions hands up to limit queued executions of the jobs of the given types over to the worker until leaseExpiresAt.
+func (r *JobsRepo) LeaseExecutions(ctx context.Context, workerID string, types []string, now int64, leaseExpiresAt int64, limit uint64) ([]repo.LeaseDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Кроме заданий с политикой allow выдается только самое раннее выполнение задания
+	// и только когда у него нет выполняющихся
+	running := make(map[string]bool)
+	earliest := make(map[string]*repo.ExecutionDTO)
+	var queued []*repo.ExecutionDTO
+	for _, e := range r.sortedExecutions() {
+		switch {
+		case e.Status == string(repo.Running):
+			running[e.JobID] = true
+		case e.Status == string(repo.Queued) && e.ScheduledAt <= now:
+			if earliest[e.JobID] == nil {
+				earliest[e.JobID] = e
+			}
+			queued = append(queued, e)
+		}
+	}
+
+	var leased []*repo.ExecutionDTO
+	for _, e := range queued {
+		job := r.jobs[e.JobID]
+		if job.Status == repo.Paused || !slices.Contains(types, job.Type) {
+			continue
+		}
+		if job.ConcurrencyPolicy != string(entity.ConcurrencyAllow) && (running[e.JobID] || earliest[e.JobID] != e) {
+			continue
+		}
+		leased = append(leased, e)
+	}
+
+	leases := make([]repo.LeaseDTO, 0, min(uint64(len(leased)), limit))
+	for _, e := range limited(leased, limit) {
+		e.WorkerID = workerID
+		e.Status = string(repo.Running)
+		e.StartedAt = now
+		e.LeaseExpiresAt = leaseExpiresAt
+
+		l := repo.LeaseDTO{Execution: *copyExecution(e), Job: *copyJob(r.jobs[e.JobID])}
+		if e.Payload != nil {
+			l.Job.Payload = clonePayload(e.Payload)
+		}
+		leases = append(leases, l)
+	}
+	return leases, nil
+}
+
+// Heartbeat records that the worker is still running the execution and extends its lease.
+// It reports whether the worker should cancel the execution because it exceeded the job timeout.
+// It returns repo.ErrConflict if the execution is not running, its lease has expired
+// or it is held by another worker.
+func (r *JobsRepo) Heartbeat(ctx context.Context, execID string, workerID string, now int64, leaseExpiresAt int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.executions[execID]
+	if !ok {
+		return false, repo.ErrNotFound
+	}
+	if !heldBy(e, workerID) || e.LeaseExpiresAt < now {
+		// Воркеру, держащему выполнение после таймаута или замены, говорят его отменить
+		if (e.Status == string(repo.TimedOut) || e.Status == string(repo.Cancelled)) && e.WorkerID == workerID {
+			return true, nil
+		}
+		return false, repo.ErrConflict
+	}
+
+	e.LeaseExpiresAt = leaseExpiresAt
+	job := r.jobs[e.JobID]
+	return job.Timeout != nil && e.StartedAt+*job.Timeout < now, nil
+}
+
+// ListTimedOut returns running executions which exceeded the timeout of their jobs.
+// Non-nil shards restricts the executions to the jobs of the owned shards.
+func (r *JobsRepo) ListTimedOut(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.ExecutionDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var timedOut []*repo.ExecutionDTO
+	for _, e := range r.executions {
+		job := r.jobs[e.JobID]
+		if e.Status == string(repo.Running) && job.Timeout != nil && e.StartedAt+*job.Timeout < now && owns(shards, e.JobID) {
+			timedOut = append(timedOut, e)
+		}
+	}
+	slices.SortFunc(timedOut, func(a, b *repo.ExecutionDTO) int {
+		return compare(a.StartedAt, b.StartedAt, a.ID, b.ID)
+	})
+	return copyExecutions(limited(timedOut, limit)), nil
+}
+
+// ListExpiredLeases returns running executions whose lease deadline is before now.
+// Non-nil shards restricts the executions to the jobs of the owned shards.
+func (r *JobsRepo) ListExpiredLeases(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.ExecutionDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var expired []*repo.ExecutionDTO
+	for _, e := range r.executions {
+		if e.Status == string(repo.Running) && e.LeaseExpiresAt < now && owns(shards, e.JobID) {
+			expired = append(expired, e)
+		}
+	}
+	slices.SortFunc(expired, func(a, b *repo.ExecutionDTO) int {
+		return compare(a.LeaseExpiresAt, b.LeaseExpiresAt, a.ID, b.ID)
+	})
+	return copyExecutions(limited(expired, limit)), nil
+}
+
+// RequeueExecution closes the expired execution and queues next in its place.
+// It returns repo.ErrConflict if the lease was extended or the execution finished meanwhile.
+func (r *JobsRepo) RequeueExecution(ctx context.Context, expired *repo.ExecutionDTO, next *repo.ExecutionDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.executions[expired.ID]
+	if !ok || e.Status != string(repo.Running) || e.LeaseExpiresAt != expired.LeaseExpiresAt {
+		return repo.ErrConflict
+	}
+	inserted, err := r.newExecutions(*next)
+	if err != nil {
+		return err
+	}
+
+	e.Status = expired.Status
+	e.Reason = expired.Reason
+	e.FinishedAt = expired.FinishedAt
+	r.insertExecutions(inserted)
+	return nil
+}
+
+// PauseJob stops firing the job and leasing its queued executions.
+// It returns repo.ErrConflict if the job is paused already.
+func (r *JobsRepo) PauseJob(ctx context.Context, jobID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[jobID]
+	if !ok {
+		return repo.ErrNotFound
+	}
+	if job.Status == repo.Paused {
+		return repo.ErrConflict
+	}
+	job.Status = repo.Paused
+	return nil
+}
+
+// ResumeJob lets the paused job fire again, replacing its misfire policy when one is given.
+// It returns repo.ErrConflict if the job is not paused.
+func (r *JobsRepo) ResumeJob(ctx context.Context, jobID string, misfirePolicy *string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, ok := r.jobs[jobID]
+	if !ok {
+		return repo.ErrNotFound
+	}
+	if job.Status != repo.Paused {
+		return repo.ErrConflict
+	}
+	if misfirePolicy != nil {
+		job.MisfirePolicy = *misfirePolicy
+	}
+	// Задание с незавершенными выполнениями снова становится running, чтобы расписание не запустило второе
+	job.Status = repo.Queued
+	if r.hasActive(jobID) {
+		job.Status = repo.Running
+	}
+	return nil
+}
+
+// ListDeadLetters returns dead letters, the most recent first.
+func (r *JobsRepo) ListDeadLetters(ctx context.Context) ([]repo.DeadLetterDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deadLetters := make([]repo.DeadLetterDTO, 0, len(r.deadLetters))
+	for _, d := range r.deadLetters {
+		deadLetters = append(deadLetters, *d)
+	}
+	slices.SortFunc(deadLetters, func(a, b repo.DeadLetterDTO) int {
+		return compare(b.CreatedAt, a.CreatedAt, b.ID, a.ID)
+	})
+	if len(deadLetters) == 0 {
+		return nil, nil
+	}
+	return deadLetters, nil
+}
+
+// RequeueDeadLetter removes the dead letter and queues exec for its job, which becomes running again.
+// It returns repo.ErrNotFound if there is no such dead letter
+// and repo.ErrConflict if the job is running meanwhile.
+func (r *JobsRepo) RequeueDeadLetter(ctx context.Context, deadLetterID string, exec *repo.ExecutionDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.deadLetters[deadLetterID]
+	if !ok {
+		return repo.ErrNotFound
+	}
+	exec.JobID = d.JobID
+	job := r.jobs[d.JobID]
+	if job.Status == repo.Running || job.Status == repo.Paused {
+		return repo.ErrConflict
+	}
+	inserted, err := r.newExecutions(*exec)
+	if err != nil {
+		return err
+	}
+
+	delete(r.deadLetters, deadLetterID)
+	job.Status = repo.Running
+	r.insertExecutions(inserted)
+	return nil
+}
+
+// DeleteDeadLetter removes the dead letter, the job and its executions are kept.
+func (r *JobsRepo) DeleteDeadLetter(ctx context.Context, deadLetterID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.deadLetters[deadLetterID]; !ok {
+		return repo.ErrNotFound
+	}
+	delete(r.deadLetters, deadLetterID)
+	return nil
+}
+
+// newJob checks that the job can be stored and returns its copy to store.
+// Once is kept as an RFC 3339 instant in UTC, as Postgres returns it.
+func (r *JobsRepo) newJob(job *repo.JobDTO) (*repo.JobDTO, error) {
+	if _, ok := r.jobs[job.ID]; ok {
+		return nil, fmt.Errorf("job %s already exists", job.ID)
+	}
+	if job.WorkflowID != nil {
+		if _, ok := r.workflows[*job.WorkflowID]; !ok {
+			return nil, fmt.Errorf("workflow %s of job %s does not exist", *job.WorkflowID, job.ID)
+		}
+	}
+	stored := copyJob(job)
+	// Как и в SQL-хранилищах, задание без меток читается с nil
+	if len(stored.Labels) == 0 {
+		stored.Labels = nil
+	}
+	if job.Once != nil {
+		once, err := time.Parse(time.RFC3339, *job.Once)
+		if err != nil {
+			return nil, fmt.Errorf("once of job %s: %w", job.ID, err)
+		}
+		s := once.UTC().Format(time.RFC3339)
+		stored.Once = &s
+	}
+	if _, err := json.Marshal(job.Payload); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func (r *JobsRepo) deleteJob(jobID string) {
+	delete(r.jobs, jobID)
+	for id, e := range r.executions {
+		if e.JobID == jobID {
+			delete(r.executions, id)
+			delete(r.logs, id)
+		}
+	}
+	for id, d := range r.deadLetters {
+		if d.JobID == jobID {
+			delete(r.deadLetters, id)
+		}
+	}
+	for id, n := range r.notifications {
+		if n.JobID == jobID {
+			delete(r.notifications, id)
+		}
+	}
+}
+
+// newExecutions checks that the executions can be inserted and returns the copies to insert.
+// Only the columns set on insert in Postgres are kept, the rest starts empty.
+func (r *JobsRepo) newExecutions(execs ...repo.ExecutionDTO) ([]*repo.ExecutionDTO, error) {
+	inserted := make([]*repo.ExecutionDTO, 0, len(execs))
+	for _, e := range execs {
+		if _, ok := r.executions[e.ID]; ok || slices.ContainsFunc(inserted, func(i *repo.ExecutionDTO) bool { return i.ID == e.ID }) {
+			return nil, fmt.Errorf("execution %s already exists", e.ID)
+		}
+		if _, ok := r.jobs[e.JobID]; !ok {
+			return nil, fmt.Errorf("job %s of execution %s does not exist", e.JobID, e.ID)
+		}
+		if e.WorkflowRunID != "" {
+			if _, ok := r.workflowRuns[e.WorkflowRunID]; !ok {
+				return nil, fmt.Errorf("workflow run %s of execution %s does not exist", e.WorkflowRunID, e.ID)
+			}
+		}
+		if _, err := json.Marshal(e.Payload); err != nil {
+			return nil, err
+		}
+		inserted = append(inserted, &repo.ExecutionDTO{
+			ID:            e.ID,
+			JobID:         e.JobID,
+			WorkerID:      e.WorkerID,
+			Status:        e.Status,
+			Attempt:       e.Attempt,
+			Trigger:       e.Trigger,
+			Payload:       clonePayload(e.Payload),
+			WorkflowRunID: e.WorkflowRunID,
+			ScheduledAt:   e.ScheduledAt,
+			StartedAt:     e.StartedAt,
+			Reason:        e.Reason,
+		})
+	}
+	return inserted, nil
+}
+
+func (r *JobsRepo) insertExecutions(execs []*repo.ExecutionDTO) {
+	for _, e := range execs {
+		r.executions[e.ID] = e
+	}
+}
+
+// finishJob sets the status of the job once its execution finished.
+// A paused job stays paused and a job with other active executions stays running.
+func (r *JobsRepo) finishJob(jobID string, status string, finishedAt int64) {
+	job, ok := r.jobs[jobID]
+	if !ok {
+		return
+	}
+	switch {
+	case job.Status == repo.Paused:
+	case r.hasActive(jobID):
+		job.Status = repo.Running
+	default:
+		job.Status = repo.Status(status)
+	}
+	job.LastFinishedAt = finishedAt
+}
+
+// hasActive tells whether the job has queued or running executions.
+func (r *JobsRepo) hasActive(jobID string) bool {
+	for _, e := range r.executions {
+		if e.JobID == jobID && isActive(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSchedule reports whether the job has the schedule of the kind, any job matches the empty one.
+func hasSchedule(job *repo.JobDTO, kind string) bool {
+	switch kind {
+	case "once":
+		return job.Once != nil
+	case "interval":
+		return job.Interval != nil
+	case "cron":
+		return job.Cron != nil
+	}
+	return true
+}
+
+// hasLabels reports whether the labels of the job satisfy all the requirements.
+func hasLabels(job *repo.JobDTO, reqs []repo.LabelRequirementDTO) bool {
+	for _, req := range reqs {
+		value, ok := job.Labels[req.Key]
+		var matched bool
+		switch req.Op {
+		case repo.LabelEquals, repo.LabelIn:
+			matched = ok && slices.Contains(req.Values, value)
+		case repo.LabelNotEquals, repo.LabelNotIn:
+			matched = !ok || !slices.Contains(req.Values, value)
+		case repo.LabelExists:
+			matched = ok
+		case repo.LabelNotExists:
+			matched = !ok
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *JobsRepo) sortedExecutions() []*repo.ExecutionDTO {
+	execs := make([]*repo.ExecutionDTO, 0, len(r.executions))
+	for _, e := range r.executions {
+		execs = append(execs, e)
+	}
+	slices.SortFunc(execs, func(a, b *repo.ExecutionDTO) int {
+		return compare(a.ScheduledAt, b.ScheduledAt, a.ID, b.ID)
+	})
+	return execs
+}
+
+// heldBy tells whether the running execution is held by the worker.
+func heldBy(e *repo.ExecutionDTO, workerID string) bool {
+	return e.Status == string(repo.Running) && e.WorkerID != "" && e.WorkerID == workerID
+}
+
+func isActive(e *repo.ExecutionDTO) bool {
+	return e.Status == string(repo.Queued) || e.Status == string(repo.Running)
+}
+
+// owns tells whether the job, or workflow run, of the ID belongs to one of the shards, nil shards own everything.
+func owns(shards *repo.ShardsDTO, id string) bool {
+	if shards == nil {
+		return true
+	}
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return slices.Contains(shards.Owned, int(h.Sum32()&0x7fffffff)%shards.Count)
+}
+
+// compare orders by the value, then by the ID to keep the order stable.
+func compare(a, b int64, aID, bID string) int {
+	return cmp.Or(cmp.Compare(a, b), strings.Compare(aID, bID))
+}
+
+func limited[T any](s []T, limit uint64) []T {
+	if uint64(len(s)) > limit {
+		return s[:limit]
+	}
+	return s
+}
+
+func copyJob(j *repo.JobDTO) *repo.JobDTO {
+	c := *j
+	c.Once = copyPtr(j.Once)
+	c.Interval = copyPtr(j.Interval)
+	c.Cron = copyPtr(j.Cron)
+	c.NextRunAt = copyPtr(j.NextRunAt)
+	c.Timeout = copyPtr(j.Timeout)
+	c.WorkflowID = copyPtr(j.WorkflowID)
+	c.Payload = clonePayload(j.Payload)
+	c.Labels = maps.Clone(j.Labels)
+	if j.RetryPolicy != nil {
+		p := *j.RetryPolicy
+		p.RetryableErrors = slices.Clone(p.RetryableErrors)
+		c.RetryPolicy = &p
+	}
+	if j.Notifications != nil {
+		c.Notifications = make([]repo.NotificationHookDTO, 0, len(j.Notifications))
+		for _, n := range j.Notifications {
+			n.Events = slices.Clone(n.Events)
+			c.Notifications = append(c.Notifications, n)
+		}
+	}
+	return &c
+}
+
+func copyExecution(e *repo.ExecutionDTO) *repo.ExecutionDTO {
+	c := *e
+	c.Payload = clonePayload(e.Payload)
+	return &c
+}
+
+func copyExecutions(execs []*repo.ExecutionDTO) []repo.ExecutionDTO {
+	if len(execs) == 0 {
+		return nil
+	}
+	res := make([]repo.ExecutionDTO, 0, len(execs))
+	for _, e := range execs {
+		res = append(res, *copyExecution(e))
+	}
+	return res
+}
+
+func copyPtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// clonePayload deep copies a payload the way a JSONB round trip does.
+// Payloads come from JSON, so they always encode, see newJob and newExecutions.
+func clonePayload(payload map[string]any) map[string]any {
+	if payload == nil {
+		return nil
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil
+	}
+	var c map[string]any
+	_ = json.Unmarshal(data, &c)
+	return c
+}
@@ -0,0 +1,12 @@
+package memory_test
+
+import (
+	"scheduler/internal/adapter/repo/memory"
+	"scheduler/internal/adapter/repo/repotest"
+	"scheduler/internal/cases"
+	"testing"
+)
+
+func TestJobsRepo(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) cases.JobsRepo { return memory.NewJobsRepo() })
+}
@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"scheduler/internal/port/repo"
+)
+
+// AppendExecutionLogs stores the chunks of the output of the execution held by the worker.
+// It returns repo.ErrConflict if the execution is not running or is held by another worker.
+func (r *JobsRepo) AppendExecutionLogs(ctx context.Context, execID string, workerID string, now int64, logs []repo.ExecutionLogDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.executions[execID]
+	if !ok {
+		return repo.ErrNotFound
+	}
+	if !heldBy(e, workerID) {
+		return repo.ErrConflict
+	}
+
+	for _, l := range logs {
+		r.logSeq++
+		r.logs[execID] = append(r.logs[execID], repo.ExecutionLogDTO{
+			Seq:         r.logSeq,
+			ExecutionID: execID,
+			Stream:      l.Stream,
+			Data:        l.Data,
+			CreatedAt:   now,
+		})
+	}
+	return nil
+}
+
+// ListExecutionLogs returns up to limit chunks of the output of the execution which follow the chunk after.
+func (r *JobsRepo) ListExecutionLogs(ctx context.Context, execID string, after int64, limit uint64) ([]repo.ExecutionLogDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var logs []repo.ExecutionLogDTO
+	for _, l := range r.logs[execID] {
+		if uint64(len(logs)) == limit {
+			break
+		}
+		if l.Seq > after {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
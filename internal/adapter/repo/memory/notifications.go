@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"slices"
+)
+
+// ListNotifications returns the deliveries of the notifications of the job, the latest first.
+// Secrets and bodies are left out as they are only needed to deliver.
+func (r *JobsRepo) ListNotifications(ctx context.Context, jobID string) ([]repo.NotificationDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var notifications []repo.NotificationDTO
+	for _, n := range r.notifications {
+		if n.JobID == jobID {
+			c := *n
+			c.Secret, c.Body = "", nil
+			notifications = append(notifications, c)
+		}
+	}
+	slices.SortFunc(notifications, func(a, b repo.NotificationDTO) int {
+		return compare(b.CreatedAt, a.CreatedAt, b.ID, a.ID)
+	})
+	return notifications, nil
+}
+
+// ClaimNotifications returns up to limit pending deliveries due by now, counting an attempt of each,
+// and hides them from other notifiers until claimUntil.
+func (r *JobsRepo) ClaimNotifications(ctx context.Context, now int64, claimUntil int64, limit uint64) ([]repo.NotificationDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []*repo.NotificationDTO
+	for _, n := range r.notifications {
+		if n.Status == entity.DeliveryPending && n.NextAttemptAt != 0 && n.NextAttemptAt <= now {
+			due = append(due, n)
+		}
+	}
+	slices.SortFunc(due, func(a, b *repo.NotificationDTO) int {
+		return compare(a.NextAttemptAt, b.NextAttemptAt, a.ID, b.ID)
+	})
+
+	var claimed []repo.NotificationDTO
+	for _, n := range limited(due, limit) {
+		n.Attempts++
+		n.NextAttemptAt = claimUntil
+		claimed = append(claimed, *copyNotification(n))
+	}
+	return claimed, nil
+}
+
+// FinishNotification stores the outcome of a delivery attempt.
+// A pending delivery is attempted again at NextAttemptAt.
+func (r *JobsRepo) FinishNotification(ctx context.Context, n *repo.NotificationDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.notifications[n.ID]
+	if !ok || stored.Status != entity.DeliveryPending {
+		return nil
+	}
+	stored.Status = n.Status
+	stored.LastStatusCode = n.LastStatusCode
+	stored.LastError = n.LastError
+	stored.NextAttemptAt = n.NextAttemptAt
+	stored.DeliveredAt = n.DeliveredAt
+	return nil
+}
+
+func copyNotification(n *repo.NotificationDTO) *repo.NotificationDTO {
+	c := *n
+	c.Body = slices.Clone(n.Body)
+	return &c
+}
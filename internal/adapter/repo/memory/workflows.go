@@ -0,0 +1,176 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"scheduler/internal/port/repo"
+	"slices"
+	"strings"
+)
+
+// CreateWorkflow stores the workflow together with the jobs of its nodes.
+func (r *JobsRepo) CreateWorkflow(ctx context.Context, workflow *repo.WorkflowDTO, jobs []repo.JobDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.workflows[workflow.ID]; ok {
+		return fmt.Errorf("workflow %s already exists", workflow.ID)
+	}
+	stored := *workflow
+	stored.Nodes = copyNodes(workflow.Nodes)
+	r.workflows[stored.ID] = &stored
+
+	// Задания узлов ссылаются на процесс, поэтому он добавляется первым и удаляется при ошибке
+	created := make([]*repo.JobDTO, 0, len(jobs))
+	for i := range jobs {
+		job, err := r.newJob(&jobs[i])
+		if err == nil && slices.ContainsFunc(created, func(c *repo.JobDTO) bool { return c.ID == job.ID }) {
+			err = fmt.Errorf("job %s already exists", job.ID)
+		}
+		if err != nil {
+			delete(r.workflows, stored.ID)
+			return err
+		}
+		created = append(created, job)
+	}
+	for _, job := range created {
+		r.jobs[job.ID] = job
+	}
+	return nil
+}
+
+// ReadWorkflow returns the workflow by its ID.
+func (r *JobsRepo) ReadWorkflow(ctx context.Context, workflowID string) (*repo.WorkflowDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w, ok := r.workflows[workflowID]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	c := *w
+	c.Nodes = copyNodes(w.Nodes)
+	return &c, nil
+}
+
+// StartWorkflowRun stores the run and queues the executions of its first nodes.
+func (r *JobsRepo) StartWorkflowRun(ctx context.Context, run *repo.WorkflowRunDTO, execs []repo.ExecutionDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.workflowRuns[run.ID]; ok {
+		return fmt.Errorf("workflow run %s already exists", run.ID)
+	}
+	if _, ok := r.workflows[run.WorkflowID]; !ok {
+		return fmt.Errorf("workflow %s of run %s does not exist", run.WorkflowID, run.ID)
+	}
+	r.workflowRuns[run.ID] = &repo.WorkflowRunDTO{
+		ID:         run.ID,
+		WorkflowID: run.WorkflowID,
+		Status:     run.Status,
+		CreatedAt:  run.CreatedAt,
+	}
+
+	if err := r.startNodes(execs); err != nil {
+		delete(r.workflowRuns, run.ID)
+		return err
+	}
+	return nil
+}
+
+// ReadWorkflowRun returns the workflow run by its ID.
+func (r *JobsRepo) ReadWorkflowRun(ctx context.Context, runID string) (*repo.WorkflowRunDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run, ok := r.workflowRuns[runID]
+	if !ok {
+		return nil, repo.ErrNotFound
+	}
+	c := *run
+	return &c, nil
+}
+
+// ListActiveWorkflowRuns returns running workflow runs, the oldest first.
+// Non-nil shards restricts the runs to the owned shards.
+func (r *JobsRepo) ListActiveWorkflowRuns(ctx context.Context, shards *repo.ShardsDTO, limit uint64) ([]repo.WorkflowRunDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var active []repo.WorkflowRunDTO
+	for _, run := range r.workflowRuns {
+		if run.Status == repo.Running && owns(shards, run.ID) {
+			active = append(active, *run)
+		}
+	}
+	slices.SortFunc(active, func(a, b repo.WorkflowRunDTO) int {
+		return compare(a.CreatedAt, b.CreatedAt, a.ID, b.ID)
+	})
+	return limited(active, limit), nil
+}
+
+// ListWorkflowRunExecutions returns the executions of the nodes of the run in the order they were scheduled.
+func (r *JobsRepo) ListWorkflowRunExecutions(ctx context.Context, runID string) ([]repo.ExecutionDTO, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var execs []*repo.ExecutionDTO
+	for _, e := range r.executions {
+		if e.WorkflowRunID == runID {
+			execs = append(execs, e)
+		}
+	}
+	slices.SortFunc(execs, func(a, b *repo.ExecutionDTO) int {
+		return cmp.Or(cmp.Compare(a.ScheduledAt, b.ScheduledAt), cmp.Compare(a.Attempt, b.Attempt), strings.Compare(a.ID, b.ID))
+	})
+	return copyExecutions(execs), nil
+}
+
+// AdvanceWorkflowRun stores the new status of the run and queues the executions of the nodes ready to start.
+// It returns repo.ErrConflict if the run has finished or been advanced since it was read.
+func (r *JobsRepo) AdvanceWorkflowRun(ctx context.Context, run *repo.WorkflowRunDTO, execs []repo.ExecutionDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.workflowRuns[run.ID]
+	if !ok || stored.Status != repo.Running || stored.Revision != run.Revision {
+		return repo.ErrConflict
+	}
+	if err := r.startNodes(execs); err != nil {
+		return err
+	}
+
+	stored.Status = run.Status
+	stored.FinishedAt = run.FinishedAt
+	stored.Revision++
+	return nil
+}
+
+// startNodes queues the executions of workflow nodes and marks their jobs as running.
+// Paused jobs stay paused, their executions wait until they are resumed.
+func (r *JobsRepo) startNodes(execs []repo.ExecutionDTO) error {
+	inserted, err := r.newExecutions(execs...)
+	if err != nil {
+		return err
+	}
+	r.insertExecutions(inserted)
+	for _, e := range inserted {
+		if job := r.jobs[e.JobID]; job.Status != repo.Paused {
+			job.Status = repo.Running
+		}
+	}
+	return nil
+}
+
+func copyNodes(nodes []repo.WorkflowNodeDTO) []repo.WorkflowNodeDTO {
+	if nodes == nil {
+		return nil
+	}
+	c := make([]repo.WorkflowNodeDTO, 0, len(nodes))
+	for _, n := range nodes {
+		n.DependsOn = slices.Clone(n.DependsOn)
+		c = append(c, n)
+	}
+	return c
+}
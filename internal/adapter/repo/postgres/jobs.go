@@ -7,8 +7,12 @@ import (
 	"fmt"
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"scheduler/internal/cases"
+	"scheduler/internal/entity"
 	"scheduler/internal/port/repo"
+	"scheduler/pkg/utils/pointers"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -17,17 +21,177 @@ var _ cases.JobsRepo = (*JobsRepo)(nil)
 
 const (
 	createQuery = `
-		INSERT INTO jobs (id, once, interval, status, created_at, last_finished_at, payload)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO jobs (id, once, interval, cron, status, created_at, last_finished_at, next_run_at, payload, timezone, retry_policy,
+			timeout_ms, misfire_policy, concurrency_policy, workflow_id, type, notifications, labels)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 	`
 	readQuery = `
-		SELECT id, once, interval, status, created_at, last_finished_at, payload
+		SELECT id, once, interval, cron, status, created_at, last_finished_at, next_run_at, payload, timezone, retry_policy,
+			timeout_ms, misfire_policy, concurrency_policy, workflow_id, type, notifications, labels
 		FROM jobs
 		WHERE id = $1
 	`
 	deleteQuery = `DELETE FROM jobs WHERE id = $1`
+
+	// Запуск по расписанию ($3 не NULL) сверяет next_run_at, чтобы один срок не запустился дважды,
+	// ручной запуск следующий запуск по расписанию не сдвигает
+	claimJobQuery = `
+		UPDATE jobs SET status = 'running', next_run_at = CASE WHEN $3::BIGINT IS NULL THEN next_run_at ELSE $2 END
+		WHERE id = $1 AND status <> 'paused' AND ($3::BIGINT IS NULL OR next_run_at = $3)
+			AND (status <> 'running' OR concurrency_policy <> 'forbid')
+		RETURNING concurrency_policy
+	`
+	cancelActiveQuery = `
+		UPDATE executions SET status = 'cancelled', reason = $2, finished_at = $3
+		WHERE job_id = $1 AND status IN ('queued', 'running')
+		RETURNING id
+	`
+	// Сравнение со считанным next_run_at защищает от повторного пропуска тех же запусков
+	skipFiresQuery = `
+		UPDATE jobs SET next_run_at = $2
+		WHERE id = $1 AND next_run_at = $3 AND status <> 'paused'
+	`
+	createExecutionQuery = `
+		INSERT INTO executions (id, job_id, worker_id, status, attempt, scheduled_at, started_at, reason, trigger, payload,
+			workflow_run_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+	finishExecutionQuery = `
+		UPDATE executions SET status = $2, finished_at = $3, output = $4, reason = $6, error = $7
+		WHERE id = $1 AND worker_id = $5 AND status = 'running'
+		RETURNING job_id
+	`
+	// Приостановленное задание остается приостановленным, когда завершается начатое до паузы выполнение,
+	// а задание с другими незавершенными выполнениями остается running
+	finishJobQuery = `
+		UPDATE jobs SET status = CASE
+			WHEN status = 'paused' THEN status
+			WHEN EXISTS (SELECT 1 FROM executions WHERE job_id = $1 AND status IN ('queued', 'running')) THEN 'running'
+			ELSE $2
+		END, last_finished_at = $3
+		WHERE id = $1
+	`
+	executionExistsQuery = `SELECT EXISTS (SELECT 1 FROM executions WHERE id = $1)`
+	jobExistsQuery       = `SELECT EXISTS (SELECT 1 FROM jobs WHERE id = $1)`
+	readExecutionQuery   = `
+		SELECT id, job_id, COALESCE(worker_id, ''), status, attempt, COALESCE(scheduled_at, 0),
+			COALESCE(started_at, 0), COALESCE(finished_at, 0), COALESCE(output, ''), COALESCE(reason, ''), trigger, payload,
+			COALESCE(workflow_run_id, ''), COALESCE(error, '')
+		FROM executions
+		WHERE id = $1
+	`
+
+	// Очередь разбирается параллельно: заблокированные другими воркерами строки пропускаются.
+	// Кроме заданий с политикой allow выдается только самое раннее выполнение задания и только
+	// когда у него нет выполняющихся; блокировка строки задания не дает двум воркерам взять по выполнению
+	leaseQuery = `
+		WITH leased AS (
+			UPDATE executions
+			SET worker_id = $1, status = 'running', started_at = $2, heartbeat_at = $2, lease_expires_at = $4
+			WHERE id IN (
+				SELECT e.id FROM executions e
+				JOIN jobs j ON j.id = e.job_id
+				WHERE e.status = 'queued' AND e.scheduled_at <= $2 AND j.status <> 'paused' AND j.type = ANY($5)
+					AND (j.concurrency_policy = 'allow' OR (
+						NOT EXISTS (SELECT 1 FROM executions r WHERE r.job_id = e.job_id AND r.status = 'running')
+						AND e.id = (
+							SELECT q.id FROM executions q
+							WHERE q.job_id = e.job_id AND q.status = 'queued' AND q.scheduled_at <= $2
+							ORDER BY q.scheduled_at, q.id
+							LIMIT 1
+						)
+					))
+				ORDER BY e.scheduled_at
+				LIMIT $3
+				FOR UPDATE OF e, j SKIP LOCKED
+			)
+			RETURNING id, job_id, worker_id, status, attempt, trigger, payload, workflow_run_id, scheduled_at, started_at,
+				lease_expires_at
+		)
+		SELECT l.id, l.job_id, l.worker_id, l.status, l.attempt, l.trigger, l.payload, COALESCE(l.workflow_run_id, ''),
+			l.scheduled_at, l.started_at, l.lease_expires_at,
+			j.id, j.once, j.interval, j.cron, j.status, j.created_at, j.last_finished_at, j.next_run_at,
+			COALESCE(l.payload, j.payload), j.timezone,
+			j.retry_policy, j.timeout_ms, j.misfire_policy, j.concurrency_policy, j.workflow_id, j.type,
+			j.notifications, j.labels
+		FROM leased l
+		JOIN jobs j ON j.id = l.job_id
+		ORDER BY l.scheduled_at
+	`
+	// Вместе с продлением аренды сообщает, истек ли таймаут задания
+	heartbeatQuery = `
+		UPDATE executions e SET heartbeat_at = $3, lease_expires_at = $4
+		FROM jobs j
+		WHERE e.id = $1 AND e.worker_id = $2 AND e.status = 'running' AND e.lease_expires_at >= $3 AND j.id = e.job_id
+		RETURNING j.timeout_ms IS NOT NULL AND e.started_at + j.timeout_ms < $3
+	`
+	executionStateQuery = `SELECT status, COALESCE(worker_id, '') FROM executions WHERE id = $1`
+	listTimedOutQuery   = `
+		SELECT e.id, e.job_id, COALESCE(e.worker_id, ''), e.attempt, e.trigger, e.payload, COALESCE(e.workflow_run_id, ''),
+			e.started_at
+		FROM executions e
+		JOIN jobs j ON j.id = e.job_id
+		WHERE e.status = 'running' AND j.timeout_ms IS NOT NULL AND e.started_at + j.timeout_ms < $1
+			AND ($3::INT IS NULL OR (hashtext(e.job_id) & 2147483647) % $3 = ANY($4))
+		ORDER BY e.started_at
+		LIMIT $2
+	`
+	listExpiredLeasesQuery = `
+		SELECT id, job_id, worker_id, attempt, trigger, payload, COALESCE(workflow_run_id, ''), lease_expires_at
+		FROM executions
+		WHERE status = 'running' AND lease_expires_at < $1
+			AND ($3::INT IS NULL OR (hashtext(job_id) & 2147483647) % $3 = ANY($4))
+		ORDER BY lease_expires_at
+		LIMIT $2
+	`
+	// Срок аренды сравнивается с прочитанным: heartbeat между чтением и обновлением отменяет возврат в очередь
+	expireLeaseQuery = `
+		UPDATE executions SET status = $2, reason = $3, finished_at = $4
+		WHERE id = $1 AND status = 'running' AND lease_expires_at = $5
+	`
+
+	createDeadLetterQuery = `
+		INSERT INTO dead_letter (id, job_id, execution_id, attempts, reason, output, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	listDeadLettersQuery = `
+		SELECT id, job_id, execution_id, attempts, COALESCE(reason, ''), COALESCE(output, ''), created_at
+		FROM dead_letter
+		ORDER BY created_at DESC
+	`
+	deleteDeadLetterQuery  = `DELETE FROM dead_letter WHERE id = $1`
+	requeueDeadLetterQuery = `DELETE FROM dead_letter WHERE id = $1 RETURNING job_id`
+	// В отличие от claimJobQuery не сдвигает следующий запуск по расписанию
+	rerunJobQuery = `
+		UPDATE jobs SET status = $2
+		WHERE id = $1 AND status NOT IN ($2, 'paused')
+	`
+
+	pauseJobQuery = `
+		UPDATE jobs SET status = 'paused'
+		WHERE id = $1 AND status <> 'paused'
+	`
+	// Задание с незавершенными выполнениями снова становится running, чтобы расписание не запустило второе
+	resumeJobQuery = `
+		UPDATE jobs SET misfire_policy = COALESCE($2, misfire_policy), status = CASE
+			WHEN EXISTS (SELECT 1 FROM executions WHERE job_id = $1 AND status IN ('queued', 'running')) THEN 'running'
+			ELSE 'queued'
+		END
+		WHERE id = $1 AND status = 'paused'
+	`
 )
 
+var jobColumns = []string{
+	"id", "once", "interval", "cron", "status", "created_at", "last_finished_at", "next_run_at", "payload", "timezone",
+	"retry_policy", "timeout_ms", "misfire_policy", "concurrency_policy", "workflow_id", "type",
+	"notifications", "labels",
+}
+
+// execer is implemented by both the pool and transactions.
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
 type JobsRepo struct {
 	db *pgxpool.Pool
 }
@@ -45,48 +209,18 @@ func (r *JobsRepo) Close() {
 }
 
 func (r *JobsRepo) Create(ctx context.Context, job *repo.JobDTO) error {
-	payloadBytes, err := json.Marshal(job.Payload)
-	if err != nil {
-		return err
-	}
-	_, err = r.db.Exec(ctx, createQuery,
-		job.ID,
-		job.Once,
-		job.Interval,
-		job.Status,
-		job.CreatedAt,
-		job.LastFinishedAt,
-		payloadBytes,
-	)
-	return err
+	return insertJob(ctx, r.db, job)
 }
 
 func (r *JobsRepo) Read(ctx context.Context, jobID string) (*repo.JobDTO, error) {
-	var job repo.JobDTO
-	var payloadBytes []byte
-
-	err := r.db.QueryRow(ctx, readQuery, jobID).Scan(
-		&job.ID,
-		&job.Once,
-		&job.Interval,
-		&job.Status,
-		&job.CreatedAt,
-		&job.LastFinishedAt,
-		&payloadBytes,
-	)
+	job, err := scanJob(r.db.QueryRow(ctx, readQuery, jobID))
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, repo.ErrNotFound
 	}
 	if err != nil {
 		return nil, err
 	}
-
-	// Unmarshal payload from JSONB
-	if err := json.Unmarshal(payloadBytes, &job.Payload); err != nil {
-		return nil, err
-	}
-
-	return &job, nil
+	return job, nil
 }
 
 func (r *JobsRepo) Delete(ctx context.Context, jobID string) error {
@@ -100,18 +234,51 @@ func (r *JobsRepo) Delete(ctx context.Context, jobID string) error {
 	return nil
 }
 
-func (r *JobsRepo) List(ctx context.Context, status *string) ([]repo.JobDTO, error) {
+// List returns up to query.Limit jobs after query.After in the order of the sort column and then of the ID.
+// Keyset pagination keeps the pages stable while jobs are created and deleted.
+func (r *JobsRepo) List(ctx context.Context, query *repo.JobsQueryDTO) ([]repo.JobDTO, error) {
 	var rows pgx.Rows
 	var err error
 	qb := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).
-		Select("id", "once", "interval", "status", "created_at", "last_finished_at", "payload").
+		Select(jobColumns...).
 		From("jobs")
 
-	if status != nil {
-		qb = qb.Where(squirrel.Eq{"status": *status})
+	if query.Status != nil {
+		qb = qb.Where(squirrel.Eq{"status": *query.Status})
+	}
+	if query.Schedule != "" {
+		qb = qb.Where(squirrel.NotEq{query.Schedule: nil})
+	}
+	if query.CreatedAfter != nil {
+		qb = qb.Where(squirrel.GtOrEq{"created_at": *query.CreatedAfter})
+	}
+	if query.CreatedBefore != nil {
+		qb = qb.Where(squirrel.Lt{"created_at": *query.CreatedBefore})
+	}
+	for _, req := range query.Labels {
+		cond, err := labelCondition(req)
+		if err != nil {
+			return nil, err
+		}
+		qb = qb.Where(cond)
+	}
+
+	// Задания без следующего запуска идут после остальных, как будто он бесконечно далек
+	key := query.Sort
+	if key == repo.SortNextRunAt {
+		key = fmt.Sprintf("COALESCE(next_run_at, %d)", repo.SortLast)
+	}
+	op, dir := ">", "ASC"
+	if query.Desc {
+		op, dir = "<", "DESC"
+	}
+	if query.After != nil {
+		qb = qb.Where(fmt.Sprintf("(%s, id) %s (?, ?)", key, op), query.After.Key, query.After.ID)
 	}
 
-	sql, args, err := qb.ToSql()
+	sql, args, err := qb.OrderBy(key+" "+dir, "id "+dir).
+		Limit(query.Limit).
+		ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -123,43 +290,44 @@ func (r *JobsRepo) List(ctx context.Context, status *string) ([]repo.JobDTO, err
 	}
 	defer rows.Close()
 
-	var jobs []repo.JobDTO
-
-	for rows.Next() {
-		var j repo.JobDTO
-		var payloadBytes []byte
-		if err := rows.Scan(
-			&j.ID,
-			&j.Once,
-			&j.Interval,
-			&j.Status,
-			&j.CreatedAt,
-			&j.LastFinishedAt,
-			&payloadBytes,
-		); err != nil {
-			return nil, err
-		}
-		jobs = append(jobs, j)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-
-	return jobs, nil
+	return collectJobs(rows)
 }
 
-func (r *JobsRepo) ListExecutions(ctx context.Context, jobID string, workerID *string) ([]repo.ExecutionDTO, error) {
+// ListExecutions returns up to query.Limit executions of the job after query.After
+// in the order of the start time and then of the ID.
+func (r *JobsRepo) ListExecutions(ctx context.Context, jobID string, query *repo.ExecutionsQueryDTO) ([]repo.ExecutionDTO, error) {
 	qb := squirrel.Select(
-		"id", "job_id", "worker_id", "status", "started_at", "finished_at",
+		"id", "job_id", "COALESCE(worker_id, '')", "status", "attempt", "trigger", "COALESCE(scheduled_at, 0)",
+		"COALESCE(started_at, 0)", "COALESCE(finished_at, 0)", "COALESCE(output, '')", "COALESCE(reason, '')",
+		"COALESCE(error, '')",
 	).From("executions").
 		Where(squirrel.Eq{"job_id": jobID}).
 		PlaceholderFormat(squirrel.Dollar) // $1, $2 для PostgreSQL
 
 	// Добавляем фильтр по worker_id, только если передан
-	if workerID != nil {
-		qb = qb.Where(squirrel.Eq{"worker_id": *workerID})
+	if query.WorkerID != nil {
+		qb = qb.Where(squirrel.Eq{"worker_id": *query.WorkerID})
+	}
+	if query.Status != nil {
+		qb = qb.Where(squirrel.Eq{"status": *query.Status})
+	}
+	if query.StartedAfter != nil {
+		qb = qb.Where(squirrel.GtOrEq{"started_at": *query.StartedAfter})
+	}
+	if query.StartedBefore != nil {
+		qb = qb.Where(squirrel.Lt{"started_at": *query.StartedBefore})
+	}
+	if query.After != nil {
+		qb = qb.Where(startedAfterCursor(query.After, query.Desc))
+	}
+
+	// Невыполнявшиеся идут после остальных, порядок по started_at берется из индекса (job_id, started_at)
+	if query.Desc {
+		qb = qb.OrderBy("started_at DESC NULLS FIRST", "id DESC")
+	} else {
+		qb = qb.OrderBy("started_at ASC NULLS LAST", "id ASC")
 	}
+	qb = qb.Limit(query.Limit)
 
 	// Генерируем SQL и аргументы
 	sql, args, err := qb.ToSql()
@@ -182,8 +350,14 @@ func (r *JobsRepo) ListExecutions(ctx context.Context, jobID string, workerID *s
 			&e.JobID,
 			&e.WorkerID,
 			&e.Status,
+			&e.Attempt,
+			&e.Trigger,
+			&e.ScheduledAt,
 			&e.StartedAt,
 			&e.FinishedAt,
+			&e.Output,
+			&e.Reason,
+			&e.Error,
 		); err != nil {
 			return nil, err
 		}
@@ -195,3 +369,719 @@ func (r *JobsRepo) ListExecutions(ctx context.Context, jobID string, workerID *s
 	}
 	return execs, nil
 }
+
+// ListDue returns jobs whose next run time is not after now and which are not paused.
+// Running jobs are returned too, their concurrency policy decides about the run.
+// Non-nil shards restricts the jobs to the owned shards.
+func (r *JobsRepo) ListDue(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.JobDTO, error) {
+	qb := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).
+		Select(jobColumns...).
+		From("jobs").
+		Where(squirrel.LtOrEq{"next_run_at": now}).
+		Where(squirrel.NotEq{"status": repo.Paused})
+
+	if shards != nil {
+		qb = qb.Where("(hashtext(id) & 2147483647) % ? = ANY(?)", shards.Count, shards.Owned)
+	}
+
+	sql, args, err := qb.OrderBy("next_run_at").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectJobs(rows)
+}
+
+// StartExecution marks the job as running and records the execution together with the skipped ones.
+// An execution without a worker is queued until a worker leases it.
+// For a scheduled run dueAt is the next run time of the job, which is moved to nextRunAt;
+// a manual run with nil dueAt does not move it.
+// Active executions of a job with the replace policy are cancelled, their IDs are returned.
+// It returns repo.ErrNotFound if there is no such job and repo.ErrConflict if the job is paused,
+// has been fired meanwhile or is running and forbids concurrent runs.
+func (r *JobsRepo) StartExecution(ctx context.Context, exec *repo.ExecutionDTO, dueAt *int64, nextRunAt *int64, skipped []repo.ExecutionDTO) ([]string, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var policy string
+	err = tx.QueryRow(ctx, claimJobQuery, exec.JobID, nextRunAt, dueAt).Scan(&policy)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, r.jobConflict(ctx, exec.JobID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var replaced []string
+	if policy == string(entity.ConcurrencyReplace) {
+		rows, err := tx.Query(ctx, cancelActiveQuery, exec.JobID, cases.ReasonReplaced, exec.ScheduledAt)
+		if err != nil {
+			return nil, err
+		}
+		if replaced, err = pgx.CollectRows(rows, pgx.RowTo[string]); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range skipped {
+		if err := insertExecution(ctx, tx, &skipped[i]); err != nil {
+			return nil, err
+		}
+	}
+	if err := insertExecution(ctx, tx, exec); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return replaced, nil
+}
+
+// SkipFires moves the next run time of the job due at dueAt without running it
+// and records the skipped executions.
+// It returns repo.ErrConflict if the job has been fired, paused or skipped meanwhile.
+func (r *JobsRepo) SkipFires(ctx context.Context, jobID string, dueAt int64, nextRunAt *int64, skipped []repo.ExecutionDTO) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := tx.Exec(ctx, skipFiresQuery, jobID, nextRunAt, dueAt)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repo.ErrConflict
+	}
+
+	for i := range skipped {
+		if err := insertExecution(ctx, tx, &skipped[i]); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// FinishExecution stores the final status of the execution held by exec.WorkerID.
+// Without retry the job gets the same status, otherwise retry is queued and the job stays running.
+// A non-nil deadLetter is recorded for the job and notifications are queued in the same transaction.
+// It returns repo.ErrConflict if the execution is not running or is held by another worker.
+func (r *JobsRepo) FinishExecution(ctx context.Context, exec *repo.ExecutionDTO, retry *repo.ExecutionDTO, deadLetter *repo.DeadLetterDTO, notifications []repo.NotificationDTO) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, finishExecutionQuery,
+		exec.ID,
+		exec.Status,
+		exec.FinishedAt,
+		exec.Output,
+		exec.WorkerID,
+		pointers.NilIfEmpty(exec.Reason),
+		pointers.NilIfEmpty(exec.Error),
+	).Scan(&exec.JobID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return r.executionConflict(ctx, exec.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if retry != nil {
+		retry.JobID = exec.JobID
+		if err := insertExecution(ctx, tx, retry); err != nil {
+			return err
+		}
+	} else if _, err := tx.Exec(ctx, finishJobQuery, exec.JobID, exec.Status, exec.FinishedAt); err != nil {
+		return err
+	}
+
+	if deadLetter != nil {
+		deadLetter.JobID = exec.JobID
+		if _, err := tx.Exec(ctx, createDeadLetterQuery,
+			deadLetter.ID,
+			deadLetter.JobID,
+			deadLetter.ExecutionID,
+			deadLetter.Attempts,
+			pointers.NilIfEmpty(deadLetter.Reason),
+			pointers.NilIfEmpty(deadLetter.Output),
+			deadLetter.CreatedAt,
+		); err != nil {
+			return err
+		}
+	}
+	for i := range notifications {
+		notifications[i].JobID = exec.JobID
+	}
+	if err := insertNotifications(ctx, tx, notifications); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ReadExecution returns the execution by its ID.
+func (r *JobsRepo) ReadExecution(ctx context.Context, execID string) (*repo.ExecutionDTO, error) {
+	var e repo.ExecutionDTO
+	var payloadBytes []byte
+	err := r.db.QueryRow(ctx, readExecutionQuery, execID).Scan(
+		&e.ID,
+		&e.JobID,
+		&e.WorkerID,
+		&e.Status,
+		&e.Attempt,
+		&e.ScheduledAt,
+		&e.StartedAt,
+		&e.FinishedAt,
+		&e.Output,
+		&e.Reason,
+		&e.Trigger,
+		&payloadBytes,
+		&e.WorkflowRunID,
+		&e.Error,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := unmarshalNullable(payloadBytes, &e.Payload); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// LeaseExecutions hands up to limit queued executions of the jobs of the given types over to the worker until leaseExpiresAt.
+func (r *JobsRepo) LeaseExecutions(ctx context.Context, workerID string, types []string, now int64, leaseExpiresAt int64, limit uint64) ([]repo.LeaseDTO, error) {
+	rows, err := r.db.Query(ctx, leaseQuery, workerID, now, limit, leaseExpiresAt, types)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var leases []repo.LeaseDTO
+	for rows.Next() {
+		var l repo.LeaseDTO
+		var once *time.Time
+		var execPayloadBytes, payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes []byte
+		if err := rows.Scan(
+			&l.Execution.ID,
+			&l.Execution.JobID,
+			&l.Execution.WorkerID,
+			&l.Execution.Status,
+			&l.Execution.Attempt,
+			&l.Execution.Trigger,
+			&execPayloadBytes,
+			&l.Execution.WorkflowRunID,
+			&l.Execution.ScheduledAt,
+			&l.Execution.StartedAt,
+			&l.Execution.LeaseExpiresAt,
+			&l.Job.ID,
+			&once,
+			&l.Job.Interval,
+			&l.Job.Cron,
+			&l.Job.Status,
+			&l.Job.CreatedAt,
+			&l.Job.LastFinishedAt,
+			&l.Job.NextRunAt,
+			&payloadBytes,
+			&l.Job.Timezone,
+			&retryPolicyBytes,
+			&l.Job.Timeout,
+			&l.Job.MisfirePolicy,
+			&l.Job.ConcurrencyPolicy,
+			&l.Job.WorkflowID,
+			&l.Job.Type,
+			&notificationsBytes,
+			&labelsBytes,
+		); err != nil {
+			return nil, err
+		}
+		if err := fillJob(&l.Job, once, payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes); err != nil {
+			return nil, err
+		}
+		if err := unmarshalNullable(execPayloadBytes, &l.Execution.Payload); err != nil {
+			return nil, err
+		}
+		leases = append(leases, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return leases, nil
+}
+
+// Heartbeat records that the worker is still running the execution and extends its lease.
+// It reports whether the worker should cancel the execution because it exceeded the job timeout.
+// It returns repo.ErrConflict if the execution is not running, its lease has expired
+// or it is held by another worker.
+func (r *JobsRepo) Heartbeat(ctx context.Context, execID string, workerID string, now int64, leaseExpiresAt int64) (bool, error) {
+	var cancel bool
+	err := r.db.QueryRow(ctx, heartbeatQuery, execID, workerID, now, leaseExpiresAt).Scan(&cancel)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return r.heartbeatConflict(ctx, execID, workerID)
+	}
+	if err != nil {
+		return false, err
+	}
+	return cancel, nil
+}
+
+// heartbeatConflict explains why a heartbeat matched no rows.
+// The worker holding a timed out or replaced execution is told to cancel it.
+func (r *JobsRepo) heartbeatConflict(ctx context.Context, execID string, workerID string) (bool, error) {
+	var status, holder string
+	err := r.db.QueryRow(ctx, executionStateQuery, execID).Scan(&status, &holder)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, repo.ErrNotFound
+	}
+	if err != nil {
+		return false, err
+	}
+	if (status == string(repo.TimedOut) || status == string(repo.Cancelled)) && holder == workerID {
+		return true, nil
+	}
+	return false, repo.ErrConflict
+}
+
+// ListTimedOut returns running executions which exceeded the timeout of their jobs.
+// Non-nil shards restricts the executions to the jobs of the owned shards.
+func (r *JobsRepo) ListTimedOut(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.ExecutionDTO, error) {
+	count, owned := shardArgs(shards)
+	rows, err := r.db.Query(ctx, listTimedOutQuery, now, limit, count, owned)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var execs []repo.ExecutionDTO
+	for rows.Next() {
+		var e repo.ExecutionDTO
+		var payloadBytes []byte
+		if err := rows.Scan(&e.ID, &e.JobID, &e.WorkerID, &e.Attempt, &e.Trigger, &payloadBytes, &e.WorkflowRunID, &e.StartedAt); err != nil {
+			return nil, err
+		}
+		if err := unmarshalNullable(payloadBytes, &e.Payload); err != nil {
+			return nil, err
+		}
+		execs = append(execs, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return execs, nil
+}
+
+// ListExpiredLeases returns running executions whose lease deadline is before now.
+// Non-nil shards restricts the executions to the jobs of the owned shards.
+func (r *JobsRepo) ListExpiredLeases(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.ExecutionDTO, error) {
+	count, owned := shardArgs(shards)
+	rows, err := r.db.Query(ctx, listExpiredLeasesQuery, now, limit, count, owned)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var execs []repo.ExecutionDTO
+	for rows.Next() {
+		var e repo.ExecutionDTO
+		var payloadBytes []byte
+		if err := rows.Scan(&e.ID, &e.JobID, &e.WorkerID, &e.Attempt, &e.Trigger, &payloadBytes, &e.WorkflowRunID, &e.LeaseExpiresAt); err != nil {
+			return nil, err
+		}
+		if err := unmarshalNullable(payloadBytes, &e.Payload); err != nil {
+			return nil, err
+		}
+		execs = append(execs, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return execs, nil
+}
+
+// RequeueExecution closes the expired execution and queues next in its place.
+// It returns repo.ErrConflict if the lease was extended or the execution finished meanwhile.
+func (r *JobsRepo) RequeueExecution(ctx context.Context, expired *repo.ExecutionDTO, next *repo.ExecutionDTO) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	res, err := tx.Exec(ctx, expireLeaseQuery,
+		expired.ID,
+		expired.Status,
+		expired.Reason,
+		expired.FinishedAt,
+		expired.LeaseExpiresAt,
+	)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repo.ErrConflict
+	}
+
+	if err := insertExecution(ctx, tx, next); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// PauseJob stops firing the job and leasing its queued executions.
+// It returns repo.ErrConflict if the job is paused already.
+func (r *JobsRepo) PauseJob(ctx context.Context, jobID string) error {
+	res, err := r.db.Exec(ctx, pauseJobQuery, jobID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return r.jobConflict(ctx, jobID)
+	}
+	return nil
+}
+
+// ResumeJob lets the paused job fire again, replacing its misfire policy when one is given.
+// It returns repo.ErrConflict if the job is not paused.
+func (r *JobsRepo) ResumeJob(ctx context.Context, jobID string, misfirePolicy *string) error {
+	res, err := r.db.Exec(ctx, resumeJobQuery, jobID, misfirePolicy)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return r.jobConflict(ctx, jobID)
+	}
+	return nil
+}
+
+// ListDeadLetters returns dead letters, the most recent first.
+func (r *JobsRepo) ListDeadLetters(ctx context.Context) ([]repo.DeadLetterDTO, error) {
+	rows, err := r.db.Query(ctx, listDeadLettersQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deadLetters []repo.DeadLetterDTO
+	for rows.Next() {
+		var d repo.DeadLetterDTO
+		if err := rows.Scan(&d.ID, &d.JobID, &d.ExecutionID, &d.Attempts, &d.Reason, &d.Output, &d.CreatedAt); err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deadLetters, nil
+}
+
+// RequeueDeadLetter removes the dead letter and queues exec for its job, which becomes running again.
+// It returns repo.ErrNotFound if there is no such dead letter
+// and repo.ErrConflict if the job is running meanwhile.
+func (r *JobsRepo) RequeueDeadLetter(ctx context.Context, deadLetterID string, exec *repo.ExecutionDTO) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, requeueDeadLetterQuery, deadLetterID).Scan(&exec.JobID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return repo.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(ctx, rerunJobQuery, exec.JobID, repo.Running)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repo.ErrConflict
+	}
+
+	if err := insertExecution(ctx, tx, exec); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// DeleteDeadLetter removes the dead letter, the job and its executions are kept.
+func (r *JobsRepo) DeleteDeadLetter(ctx context.Context, deadLetterID string) error {
+	res, err := r.db.Exec(ctx, deleteDeadLetterQuery, deadLetterID)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+// executionConflict explains why an update guarded by worker and status matched no rows.
+func (r *JobsRepo) executionConflict(ctx context.Context, execID string) error {
+	var exists bool
+	if err := r.db.QueryRow(ctx, executionExistsQuery, execID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return repo.ErrNotFound
+	}
+	return repo.ErrConflict
+}
+
+// jobConflict explains why an update guarded by the job status matched no rows.
+func (r *JobsRepo) jobConflict(ctx context.Context, jobID string) error {
+	var exists bool
+	if err := r.db.QueryRow(ctx, jobExistsQuery, jobID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return repo.ErrNotFound
+	}
+	return repo.ErrConflict
+}
+
+// labelCondition translates a label requirement into SQL. Containment (@>) and existence (?) of keys
+// are served by the GIN index on labels, ?? escapes the operator from the placeholders.
+func labelCondition(req repo.LabelRequirementDTO) (squirrel.Sqlizer, error) {
+	contains := make([]any, 0, len(req.Values))
+	for _, v := range req.Values {
+		b, err := json.Marshal(map[string]string{req.Key: v})
+		if err != nil {
+			return nil, err
+		}
+		contains = append(contains, string(b))
+	}
+
+	switch req.Op {
+	case repo.LabelEquals, repo.LabelIn:
+		or := squirrel.Or{}
+		for _, c := range contains {
+			or = append(or, squirrel.Expr("labels @> ?::JSONB", c))
+		}
+		return or, nil
+	case repo.LabelNotEquals, repo.LabelNotIn:
+		and := squirrel.And{}
+		for _, c := range contains {
+			and = append(and, squirrel.Expr("NOT (labels @> ?::JSONB)", c))
+		}
+		return and, nil
+	case repo.LabelExists:
+		return squirrel.Expr("labels ?? ?", req.Key), nil
+	case repo.LabelNotExists:
+		return squirrel.Expr("NOT (labels ?? ?)", req.Key), nil
+	}
+	return nil, fmt.Errorf("unknown label operator %q", req.Op)
+}
+
+// startedAfterCursor selects the executions which follow the cursor in the order of the start time.
+// The executions which have not started have no start time and come last in ascending order.
+func startedAfterCursor(after *repo.CursorDTO, desc bool) squirrel.Sqlizer {
+	switch {
+	case after.Key == repo.SortLast && !desc:
+		return squirrel.Expr("(started_at IS NULL AND id > ?)", after.ID)
+	case after.Key == repo.SortLast:
+		return squirrel.Expr("(started_at IS NOT NULL OR id < ?)", after.ID)
+	case !desc:
+		return squirrel.Expr("(started_at > ? OR (started_at = ? AND id > ?) OR started_at IS NULL)", after.Key, after.Key, after.ID)
+	default:
+		return squirrel.Expr("(started_at < ? OR (started_at = ? AND id < ?))", after.Key, after.Key, after.ID)
+	}
+}
+
+// shardArgs returns the parameters of a query filtering by shard, both NULL without shards.
+func shardArgs(shards *repo.ShardsDTO) (*int, []int) {
+	if shards == nil {
+		return nil, nil
+	}
+	return &shards.Count, shards.Owned
+}
+
+// insertJob stores the job through the pool or within a transaction.
+func insertJob(ctx context.Context, db execer, job *repo.JobDTO) error {
+	payloadBytes, err := json.Marshal(job.Payload)
+	if err != nil {
+		return err
+	}
+	retryPolicyBytes, err := marshalNullable(job.RetryPolicy)
+	if err != nil {
+		return err
+	}
+	var notificationsBytes []byte
+	if len(job.Notifications) > 0 {
+		if notificationsBytes, err = json.Marshal(job.Notifications); err != nil {
+			return err
+		}
+	}
+	labels := job.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labelsBytes, err := json.Marshal(labels)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(ctx, createQuery,
+		job.ID,
+		job.Once,
+		job.Interval,
+		job.Cron,
+		job.Status,
+		job.CreatedAt,
+		job.LastFinishedAt,
+		job.NextRunAt,
+		payloadBytes,
+		job.Timezone,
+		retryPolicyBytes,
+		job.Timeout,
+		job.MisfirePolicy,
+		job.ConcurrencyPolicy,
+		job.WorkflowID,
+		job.Type,
+		notificationsBytes,
+		labelsBytes,
+	)
+	return err
+}
+
+func insertExecution(ctx context.Context, tx pgx.Tx, exec *repo.ExecutionDTO) error {
+	// NULL payload means the payload of the job
+	var payloadBytes []byte
+	if exec.Payload != nil {
+		var err error
+		if payloadBytes, err = json.Marshal(exec.Payload); err != nil {
+			return err
+		}
+	}
+	_, err := tx.Exec(ctx, createExecutionQuery,
+		exec.ID,
+		exec.JobID,
+		pointers.NilIfEmpty(exec.WorkerID),
+		exec.Status,
+		exec.Attempt,
+		exec.ScheduledAt,
+		pointers.NilIfZero(exec.StartedAt),
+		pointers.NilIfEmpty(exec.Reason),
+		exec.Trigger,
+		payloadBytes,
+		pointers.NilIfEmpty(exec.WorkflowRunID),
+	)
+	return err
+}
+
+func scanJob(row pgx.Row) (*repo.JobDTO, error) {
+	var job repo.JobDTO
+	var once *time.Time
+	var payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes []byte
+
+	if err := row.Scan(
+		&job.ID,
+		&once,
+		&job.Interval,
+		&job.Cron,
+		&job.Status,
+		&job.CreatedAt,
+		&job.LastFinishedAt,
+		&job.NextRunAt,
+		&payloadBytes,
+		&job.Timezone,
+		&retryPolicyBytes,
+		&job.Timeout,
+		&job.MisfirePolicy,
+		&job.ConcurrencyPolicy,
+		&job.WorkflowID,
+		&job.Type,
+		&notificationsBytes,
+		&labelsBytes,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := fillJob(&job, once, payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// fillJob sets the job fields which need conversion after scanning.
+func fillJob(job *repo.JobDTO, once *time.Time, payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes []byte) error {
+	if once != nil {
+		s := once.UTC().Format(time.RFC3339)
+		job.Once = &s
+	}
+	if err := unmarshalNullable(retryPolicyBytes, &job.RetryPolicy); err != nil {
+		return err
+	}
+	if err := unmarshalNullable(notificationsBytes, &job.Notifications); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(labelsBytes, &job.Labels); err != nil {
+		return err
+	}
+	// Задание без меток читается так же, как создавалось
+	if len(job.Labels) == 0 {
+		job.Labels = nil
+	}
+
+	// Unmarshal payload from JSONB
+	return json.Unmarshal(payloadBytes, &job.Payload)
+}
+
+// unmarshalNullable decodes a nullable JSONB column, v is left untouched for NULL.
+func unmarshalNullable(data []byte, v any) error {
+	if data == nil {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// marshalNullable encodes v for a nullable JSONB column.
+func marshalNullable[T any](v *T) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func collectJobs(rows pgx.Rows) ([]repo.JobDTO, error) {
+	var jobs []repo.JobDTO
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *j)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
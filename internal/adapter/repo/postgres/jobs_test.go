@@ -0,0 +1,54 @@
+package postgres_test
+
+import (
+	"database/sql"
+	"os"
+	"scheduler/internal/adapter/repo/postgres"
+	"scheduler/internal/adapter/repo/repotest"
+	"scheduler/internal/cases"
+	migrations "scheduler/pkg/migration/postgres"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// truncateQuery empties every table of the schema but the migration versions.
+const truncateQuery = `
+	DO $$
+	DECLARE t TEXT;
+	BEGIN
+		FOR t IN SELECT tablename FROM pg_tables WHERE schemaname = current_schema() AND tablename <> 'goose_db_version' LOOP
+			EXECUTE 'TRUNCATE TABLE ' || quote_ident(t) || ' CASCADE';
+		END LOOP;
+	END $$
+`
+
+// TestJobsRepo runs the conformance suite against the database of POSTGRES_TEST_CONNECTION_STRING.
+// The database is migrated and emptied before every case, so don't point it at real data.
+func TestJobsRepo(t *testing.T) {
+	connStr := os.Getenv("POSTGRES_TEST_CONNECTION_STRING")
+	if connStr == "" {
+		t.Skip("POSTGRES_TEST_CONNECTION_STRING is not set")
+	}
+
+	db, err := sql.Open("pgx", connStr)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+	if err := migrations.Migrate(db); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	repotest.Run(t, func(t *testing.T) cases.JobsRepo {
+		if _, err := db.Exec(truncateQuery); err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		r, err := postgres.NewJobsRepo(connStr)
+		if err != nil {
+			t.Fatalf("new repo: %v", err)
+		}
+		t.Cleanup(r.Close)
+		return r
+	})
+}
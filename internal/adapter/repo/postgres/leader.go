@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultLeaderCheckInterval = time.Second
+
+	// leaderLockKey identifies the advisory lock held by the leading scheduler replica
+	leaderLockKey int64 = 0x5343484544554c45 // "SCHEDULE"
+
+	tryLeaderLockQuery = `SELECT pg_try_advisory_lock($1)`
+	leaderUnlockQuery  = `SELECT pg_advisory_unlock($1)`
+	// Проверка соединения: блокировка живет, пока жива сессия, которая ее взяла
+	leaderPingQuery = `SELECT 1`
+)
+
+// LeaderElector elects one leader among the replicas sharing a database
+// with a session-level advisory lock.
+//
+// The lock is held by a connection taken out of the pool for the whole term,
+// so Postgres releases it as soon as the session of the leader ends, e.g. when
+// the replica crashes or loses the network. The other replicas retry every
+// check interval and the leader pings its connection equally often to step down
+// once the lock may be lost. A ping which gets no answer within the interval counts
+// as a lost connection, so a hung leader steps down in about two intervals.
+type LeaderElector struct {
+	db       *pgxpool.Pool
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+func NewLeaderElector(jobsRepo *JobsRepo, logger *zap.Logger, interval time.Duration) *LeaderElector {
+	if interval <= 0 {
+		interval = defaultLeaderCheckInterval
+	}
+	return &LeaderElector{
+		db:       jobsRepo.db,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Run calls lead whenever this replica becomes the leader until ctx is cancelled.
+// The context passed to lead is cancelled when the leadership is lost,
+// lead is expected to return promptly then.
+func (l *LeaderElector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	for {
+		conn, err := l.acquire(ctx)
+		if err != nil && ctx.Err() == nil {
+			l.logger.Error("try leader lock", zap.Error(err))
+		}
+		if conn != nil {
+			l.logger.Info("became leader")
+			l.hold(ctx, conn, ticker, lead)
+			l.logger.Info("stepped down as leader")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// acquire returns the connection holding the lock, or nil if another replica leads.
+func (l *LeaderElector) acquire(ctx context.Context) (*pgxpool.Conn, error) {
+	conn, err := l.db.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked bool
+	if err := conn.QueryRow(ctx, tryLeaderLockQuery, leaderLockKey).Scan(&locked); err != nil {
+		conn.Release()
+		return nil, err
+	}
+	if !locked {
+		conn.Release()
+		return nil, nil
+	}
+	return conn, nil
+}
+
+// hold runs lead while the connection stays alive and releases the lock afterwards.
+func (l *LeaderElector) hold(ctx context.Context, conn *pgxpool.Conn, ticker *time.Ticker, lead func(ctx context.Context)) {
+	leadCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lead(leadCtx)
+	}()
+
+	alive := true
+	for alive {
+		select {
+		case <-ctx.Done():
+			alive = false
+		case <-done:
+			alive = false
+		case <-ticker.C:
+			// Зависшее соединение (полуоткрытый TCP, разрыв сети без RST) ждет до одного интервала,
+			// иначе лидер продолжал бы раздавать задания, пока ядро не сдастся
+			pingCtx, pingCancel := context.WithTimeout(ctx, l.interval)
+			_, err := conn.Exec(pingCtx, leaderPingQuery)
+			pingCancel()
+			if err != nil {
+				if ctx.Err() == nil {
+					l.logger.Warn("leader connection lost", zap.Error(err))
+				}
+				alive = false
+			}
+		}
+	}
+
+	cancel()
+	<-done
+
+	// Закрытое соединение не возвращается в пул, а вместе с сессией освобождается и блокировка.
+	// Соединение, прерванное по таймауту проверки, pgx уже закрыл, снимать с него блокировку незачем
+	unlockCtx, unlockCancel := context.WithTimeout(context.WithoutCancel(ctx), l.interval)
+	defer unlockCancel()
+	if conn.Conn().IsClosed() {
+		conn.Release()
+		return
+	}
+	if _, err := conn.Exec(unlockCtx, leaderUnlockQuery, leaderLockKey); err != nil {
+		_ = conn.Conn().Close(unlockCtx)
+	}
+	conn.Release()
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"scheduler/internal/port/repo"
+)
+
+const (
+	// Куски добавляются только к выполнению, которое держит этот воркер, в порядке их передачи
+	appendExecutionLogsQuery = `
+		INSERT INTO execution_logs (execution_id, stream, data, created_at)
+		SELECT e.id, c.stream, c.data, $3
+		FROM executions e, unnest($4::TEXT[], $5::TEXT[]) WITH ORDINALITY AS c(stream, data, n)
+		WHERE e.id = $1 AND e.worker_id = $2 AND e.status = 'running'
+		ORDER BY c.n
+	`
+	listExecutionLogsQuery = `
+		SELECT seq, execution_id, stream, data, created_at
+		FROM execution_logs
+		WHERE execution_id = $1 AND seq > $2
+		ORDER BY seq
+		LIMIT $3
+	`
+)
+
+// AppendExecutionLogs stores the chunks of the output of the execution held by the worker.
+// It returns repo.ErrConflict if the execution is not running or is held by another worker.
+func (r *JobsRepo) AppendExecutionLogs(ctx context.Context, execID string, workerID string, now int64, logs []repo.ExecutionLogDTO) error {
+	streams := make([]string, 0, len(logs))
+	data := make([]string, 0, len(logs))
+	for _, l := range logs {
+		streams = append(streams, l.Stream)
+		data = append(data, l.Data)
+	}
+
+	res, err := r.db.Exec(ctx, appendExecutionLogsQuery, execID, workerID, now, streams, data)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return r.executionConflict(ctx, execID)
+	}
+	return nil
+}
+
+// ListExecutionLogs returns up to limit chunks of the output of the execution which follow the chunk after.
+// The chunks of a single worker are appended one request after another, so they become visible in order.
+func (r *JobsRepo) ListExecutionLogs(ctx context.Context, execID string, after int64, limit uint64) ([]repo.ExecutionLogDTO, error) {
+	rows, err := r.db.Query(ctx, listExecutionLogsQuery, execID, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var logs []repo.ExecutionLogDTO
+	for rows.Next() {
+		var l repo.ExecutionLogDTO
+		if err := rows.Scan(&l.Seq, &l.ExecutionID, &l.Stream, &l.Data, &l.CreatedAt); err != nil {
+			return nil, err
+		}
+		logs = append(logs, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
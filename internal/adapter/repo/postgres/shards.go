@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"scheduler/internal/cases"
+	"scheduler/internal/port/repo"
+)
+
+var _ cases.Shards = (*ShardCoordinator)(nil)
+
+const (
+	defaultShardCount             = 64
+	defaultShardRebalanceInterval = 5 * time.Second
+
+	// shardLeaseIntervals is the lifetime of shard leases and memberships in rebalance intervals,
+	// so that a single failed renewal does not hand the shards over
+	shardLeaseIntervals = 3
+
+	// Строки лишних шардов удаляются, если их число уменьшили
+	createShardsQuery = `
+		INSERT INTO scheduler_shards (shard)
+		SELECT generate_series(0, $1 - 1)
+		ON CONFLICT DO NOTHING
+	`
+	deleteExtraShardsQuery = `DELETE FROM scheduler_shards WHERE shard >= $1`
+
+	upsertMemberQuery = `
+		INSERT INTO scheduler_members (id, expires_at) VALUES ($1, $2)
+		ON CONFLICT (id) DO UPDATE SET expires_at = EXCLUDED.expires_at
+	`
+	deleteExpiredMembersQuery = `DELETE FROM scheduler_members WHERE expires_at < $1`
+	deleteMemberQuery         = `DELETE FROM scheduler_members WHERE id = $1`
+	countMembersQuery         = `SELECT COUNT(*) FROM scheduler_members`
+
+	renewShardsQuery = `
+		UPDATE scheduler_shards SET lease_expires_at = $3
+		WHERE owner = $1 AND lease_expires_at >= $2
+		RETURNING shard
+	`
+	// Свободными считаются и шарды с истекшей арендой, в том числе собственные
+	claimShardsQuery = `
+		UPDATE scheduler_shards SET owner = $1, lease_expires_at = $3
+		WHERE shard IN (
+			SELECT shard FROM scheduler_shards
+			WHERE owner IS NULL OR lease_expires_at < $2
+			ORDER BY shard
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING shard
+	`
+	releaseShardsQuery = `
+		UPDATE scheduler_shards SET owner = NULL, lease_expires_at = 0
+		WHERE owner = $1 AND shard = ANY($2)
+	`
+	releaseAllShardsQuery = `
+		UPDATE scheduler_shards SET owner = NULL, lease_expires_at = 0
+		WHERE owner = $1
+	`
+)
+
+// ShardCoordinator splits the jobs between the live scheduler replicas.
+//
+// Jobs are hashed by ID into a fixed number of shards, which must be the same for all
+// replicas. Every replica renews its membership and its shard leases on each rebalance
+// and holds ceil(shards / members) of them: replicas release extra shards when another
+// one joins and claim the shards of a replica which left or stopped renewing.
+// A shard is dispatched only while its lease is known to be valid.
+type ShardCoordinator struct {
+	db       *pgxpool.Pool
+	logger   *zap.Logger
+	memberID string
+	count    int
+	interval time.Duration
+
+	mu         sync.Mutex
+	owned      []int
+	validUntil time.Time
+}
+
+func NewShardCoordinator(jobsRepo *JobsRepo, logger *zap.Logger, memberID string, count int, interval time.Duration) *ShardCoordinator {
+	if count <= 0 {
+		count = defaultShardCount
+	}
+	if interval <= 0 {
+		interval = defaultShardRebalanceInterval
+	}
+	return &ShardCoordinator{
+		db:       jobsRepo.db,
+		logger:   logger,
+		memberID: memberID,
+		count:    count,
+		interval: interval,
+	}
+}
+
+// Owned returns the shards whose leases this replica holds.
+func (c *ShardCoordinator) Owned() repo.ShardsDTO {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Now().After(c.validUntil) {
+		return repo.ShardsDTO{Count: c.count}
+	}
+	return repo.ShardsDTO{Count: c.count, Owned: slices.Clone(c.owned)}
+}
+
+// Run rebalances the shards until ctx is cancelled and then hands them over to the other replicas.
+func (c *ShardCoordinator) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	initialized := false
+	for {
+		if !initialized {
+			if err := c.init(ctx); err != nil {
+				c.logger.Error("create shards", zap.Error(err))
+			} else {
+				initialized = true
+			}
+		}
+		if initialized {
+			if err := c.rebalance(ctx); err != nil && ctx.Err() == nil {
+				c.logger.Error("rebalance shards", zap.Error(err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			c.leave(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *ShardCoordinator) init(ctx context.Context) error {
+	if _, err := c.db.Exec(ctx, createShardsQuery, c.count); err != nil {
+		return err
+	}
+	_, err := c.db.Exec(ctx, deleteExtraShardsQuery, c.count)
+	return err
+}
+
+func (c *ShardCoordinator) rebalance(ctx context.Context) error {
+	now := time.Now()
+	expiresAt := now.Add(shardLeaseIntervals * c.interval)
+
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, upsertMemberQuery, c.memberID, expiresAt.UnixMilli()); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, deleteExpiredMembersQuery, now.UnixMilli()); err != nil {
+		return err
+	}
+	var members int
+	if err := tx.QueryRow(ctx, countMembersQuery).Scan(&members); err != nil {
+		return err
+	}
+	target := (c.count + members - 1) / members
+
+	owned, err := collectShards(tx.Query(ctx, renewShardsQuery, c.memberID, now.UnixMilli(), expiresAt.UnixMilli()))
+	if err != nil {
+		return err
+	}
+	slices.Sort(owned)
+
+	switch {
+	case len(owned) > target:
+		if _, err := tx.Exec(ctx, releaseShardsQuery, c.memberID, owned[target:]); err != nil {
+			return err
+		}
+		owned = owned[:target]
+	case len(owned) < target:
+		claimed, err := collectShards(tx.Query(ctx, claimShardsQuery, c.memberID, now.UnixMilli(), expiresAt.UnixMilli(), target-len(owned)))
+		if err != nil {
+			return err
+		}
+		owned = append(owned, claimed...)
+		slices.Sort(owned)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !slices.Equal(owned, c.owned) {
+		c.logger.Info("shards rebalanced", zap.Ints("shards", owned), zap.Int("members", members))
+	}
+	c.owned = owned
+	// Шарды перестают обрабатываться за интервал до истечения аренды
+	c.validUntil = expiresAt.Add(-c.interval)
+	return nil
+}
+
+// leave releases the shards and the membership so that other replicas take over at once.
+func (c *ShardCoordinator) leave(ctx context.Context) {
+	c.mu.Lock()
+	c.owned = nil
+	c.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.interval)
+	defer cancel()
+	if _, err := c.db.Exec(ctx, releaseAllShardsQuery, c.memberID); err != nil {
+		c.logger.Error("release shards", zap.Error(err))
+	}
+	if _, err := c.db.Exec(ctx, deleteMemberQuery, c.memberID); err != nil {
+		c.logger.Error("leave shards", zap.Error(err))
+	}
+}
+
+func collectShards(rows pgx.Rows, err error) ([]int, error) {
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[int])
+}
@@ -0,0 +1,1032 @@
+// Package repotest is the conformance suite of the cases.JobsRepo implementations.
+//
+// Every storage adapter runs it from its tests against a fresh repository per case:
+//
+//	repotest.Run(t, func(t *testing.T) cases.JobsRepo { return memory.NewJobsRepo() })
+//
+// The cases pin down the semantics the scheduler relies on: repo.ErrNotFound and
+// repo.ErrConflict, the guards of the updates, cascading deletes and the order of listings.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"scheduler/internal/cases"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// NewRepo returns an empty repository, cleaned up by the test when needed.
+type NewRepo func(t *testing.T) cases.JobsRepo
+
+// now is the base time of the cases in Unix milliseconds, all times are relative to it.
+const now int64 = 1_800_000_000_000
+
+var testCases = []struct {
+	name string
+	run  func(t *testing.T, r cases.JobsRepo)
+}{
+	{"CreateRead", testCreateRead},
+	{"Delete", testDelete},
+	{"List", testList},
+	{"ListPages", testListPages},
+	{"ListLabels", testListLabels},
+	{"ListExecutionPages", testListExecutionPages},
+	{"ListDue", testListDue},
+	{"StartExecution", testStartExecution},
+	{"StartExecutionForbid", testStartExecutionForbid},
+	{"StartExecutionReplace", testStartExecutionReplace},
+	{"SkipFires", testSkipFires},
+	{"LeaseExecutions", testLeaseExecutions},
+	{"LeaseExecutionsConcurrency", testLeaseExecutionsConcurrency},
+	{"Heartbeat", testHeartbeat},
+	{"FinishExecution", testFinishExecution},
+	{"FinishExecutionRetry", testFinishExecutionRetry},
+	{"ListTimedOut", testListTimedOut},
+	{"RequeueExecution", testRequeueExecution},
+	{"PauseResume", testPauseResume},
+	{"DeadLetters", testDeadLetters},
+	{"Workflows", testWorkflows},
+	{"ExecutionLogs", testExecutionLogs},
+	{"Notifications", testNotifications},
+	{"Shards", testShards},
+}
+
+// Run runs the conformance suite, every case gets its own repository from newRepo.
+func Run(t *testing.T, newRepo NewRepo) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.run(t, newRepo(t))
+		})
+	}
+}
+
+func testCreateRead(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	once := "2027-01-02T03:04:05+03:00"
+	timeout := int64(60_000)
+	job.Once = &once
+	job.Payload = map[string]any{"n": 1.5, "nested": map[string]any{"list": []any{"a", true}}}
+	job.RetryPolicy = &repo.RetryPolicyDTO{MaxAttempts: 3, InitialDelay: "1s", Multiplier: 2, RetryableErrors: []string{"timeout"}}
+	job.Timeout = &timeout
+	job.Notifications = []repo.NotificationHookDTO{{URL: "https://example.com/hook", Secret: "s", Events: []string{"failed"}}}
+	job.Labels = map[string]string{"team": "billing", "example.com/tier": ""}
+	mustCreate(t, r, job)
+
+	got, err := r.Read(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if got.Once == nil || *got.Once != "2027-01-02T00:04:05Z" {
+		t.Errorf("once = %v, want the instant in UTC", deref(got.Once))
+	}
+	if got.Type != job.Type || got.Status != job.Status || got.Timezone != job.Timezone ||
+		got.MisfirePolicy != job.MisfirePolicy || got.ConcurrencyPolicy != job.ConcurrencyPolicy ||
+		deref(got.NextRunAt) != deref(job.NextRunAt) || deref(got.Timeout) != timeout || got.CreatedAt != job.CreatedAt {
+		t.Errorf("read %+v, want %+v", got, job)
+	}
+	if nested, _ := got.Payload["nested"].(map[string]any); got.Payload["n"] != 1.5 || nested == nil {
+		t.Errorf("payload = %v", got.Payload)
+	}
+	if got.RetryPolicy == nil || got.RetryPolicy.MaxAttempts != 3 || !slices.Equal(got.RetryPolicy.RetryableErrors, []string{"timeout"}) {
+		t.Errorf("retry policy = %+v", got.RetryPolicy)
+	}
+	if len(got.Notifications) != 1 || got.Notifications[0].Secret != "s" || !slices.Equal(got.Notifications[0].Events, []string{"failed"}) {
+		t.Errorf("notifications = %+v", got.Notifications)
+	}
+	if !maps.Equal(got.Labels, job.Labels) {
+		t.Errorf("labels = %v, want %v", got.Labels, job.Labels)
+	}
+
+	// Изменение прочитанного задания не меняет хранимое
+	got.Payload["n"] = 2.0
+	if again, _ := r.Read(ctx, job.ID); again.Payload["n"] != 1.5 {
+		t.Errorf("stored payload changed through a read copy")
+	}
+
+	if _, err := r.Read(ctx, uuid.NewString()); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("read missing job: %v, want ErrNotFound", err)
+	}
+}
+
+func testDelete(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+	exec := mustStart(t, r, job.ID, nil)
+	leaseAll(t, r, "w1")
+	finish(t, r, exec, repo.Failed, nil, &repo.DeadLetterDTO{ID: uuid.NewString(), ExecutionID: exec.ID, Attempts: 1, CreatedAt: now})
+
+	if err := r.Delete(ctx, job.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := r.Read(ctx, job.ID); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("read deleted job: %v, want ErrNotFound", err)
+	}
+	if _, err := r.ReadExecution(ctx, exec.ID); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("read execution of deleted job: %v, want ErrNotFound", err)
+	}
+	if deadLetters, _ := r.ListDeadLetters(ctx); len(deadLetters) != 0 {
+		t.Errorf("dead letters of deleted job = %d, want 0", len(deadLetters))
+	}
+	if err := r.Delete(ctx, job.ID); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("delete missing job: %v, want ErrNotFound", err)
+	}
+}
+
+func testList(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	queued, paused := newJob(now), newJob(now)
+	mustCreate(t, r, queued)
+	mustCreate(t, r, paused)
+	if err := r.PauseJob(ctx, paused.ID); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+
+	all, err := r.List(ctx, &repo.JobsQueryDTO{Sort: repo.SortCreatedAt, Limit: 10})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("list = %d jobs, want 2", len(all))
+	}
+	status := string(repo.Paused)
+	filtered, err := r.List(ctx, &repo.JobsQueryDTO{Status: &status, Sort: repo.SortCreatedAt, Limit: 10})
+	if err != nil {
+		t.Fatalf("list paused: %v", err)
+	}
+	if len(filtered) != 1 || filtered[0].ID != paused.ID {
+		t.Errorf("list paused = %v, want %s", jobIDs(filtered), paused.ID)
+	}
+}
+
+func testListPages(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	// Задания созданы в разное время, у последнего нет следующего запуска
+	first, second, third, done := newJob(now+3000), newJob(now+1000), newJob(now+2000), newJob(now)
+	first.CreatedAt, second.CreatedAt, third.CreatedAt, done.CreatedAt = now-4000, now-3000, now-2000, now-1000
+	done.NextRunAt = nil
+	third.Interval, third.Cron = nil, ptr("* * * * *")
+	for _, job := range []*repo.JobDTO{first, second, third, done} {
+		mustCreate(t, r, job)
+	}
+
+	// Страницы по два задания склеиваются в полный список без пропусков и повторов
+	pages := func(query repo.JobsQueryDTO) []string {
+		t.Helper()
+		var ids []string
+		for range 4 {
+			page, err := r.List(ctx, &query)
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			ids = append(ids, jobIDs(page)...)
+			if uint64(len(page)) < query.Limit {
+				return ids
+			}
+			last := page[len(page)-1]
+			query.After = &repo.CursorDTO{Key: last.SortKey(query.Sort), ID: last.ID}
+		}
+		t.Fatalf("list did not end")
+		return nil
+	}
+
+	for _, tc := range []struct {
+		name  string
+		query repo.JobsQueryDTO
+		want  []string
+	}{
+		{"created", repo.JobsQueryDTO{Sort: repo.SortCreatedAt}, []string{first.ID, second.ID, third.ID, done.ID}},
+		{"created desc", repo.JobsQueryDTO{Sort: repo.SortCreatedAt, Desc: true}, []string{done.ID, third.ID, second.ID, first.ID}},
+		{"next run", repo.JobsQueryDTO{Sort: repo.SortNextRunAt}, []string{second.ID, third.ID, first.ID, done.ID}},
+		{"next run desc", repo.JobsQueryDTO{Sort: repo.SortNextRunAt, Desc: true}, []string{done.ID, first.ID, third.ID, second.ID}},
+		{"cron", repo.JobsQueryDTO{Sort: repo.SortCreatedAt, Schedule: "cron"}, []string{third.ID}},
+		{"interval", repo.JobsQueryDTO{Sort: repo.SortCreatedAt, Schedule: "interval"}, []string{first.ID, second.ID, done.ID}},
+		{"created range", repo.JobsQueryDTO{Sort: repo.SortCreatedAt, CreatedAfter: ptr(now - 3000), CreatedBefore: ptr(now - 1000)}, []string{second.ID, third.ID}},
+	} {
+		tc.query.Limit = 2
+		if got := pages(tc.query); !slices.Equal(got, tc.want) {
+			t.Errorf("%s = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func testListLabels(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	billing, prod, bare := newJob(now), newJob(now), newJob(now)
+	billing.CreatedAt, prod.CreatedAt, bare.CreatedAt = now-3000, now-2000, now-1000
+	billing.Labels = map[string]string{"team": "billing", "env": "dev", "tier": "a"}
+	prod.Labels = map[string]string{"team": "billing", "env": "prod", "tier": "b"}
+	for _, job := range []*repo.JobDTO{billing, prod, bare} {
+		mustCreate(t, r, job)
+	}
+
+	label := func(key, op string, values ...string) repo.LabelRequirementDTO {
+		return repo.LabelRequirementDTO{Key: key, Op: op, Values: values}
+	}
+	for _, tc := range []struct {
+		name   string
+		labels []repo.LabelRequirementDTO
+		want   []string
+	}{
+		{"equals", []repo.LabelRequirementDTO{label("team", repo.LabelEquals, "billing")}, []string{billing.ID, prod.ID}},
+		// Задание без метки удовлетворяет отрицательным требованиям
+		{"not equals", []repo.LabelRequirementDTO{label("env", repo.LabelNotEquals, "prod")}, []string{billing.ID, bare.ID}},
+		{"in", []repo.LabelRequirementDTO{label("tier", repo.LabelIn, "b", "c")}, []string{prod.ID}},
+		{"not in", []repo.LabelRequirementDTO{label("tier", repo.LabelNotIn, "a", "b")}, []string{bare.ID}},
+		{"exists", []repo.LabelRequirementDTO{label("env", repo.LabelExists)}, []string{billing.ID, prod.ID}},
+		{"not exists", []repo.LabelRequirementDTO{label("team", repo.LabelNotExists)}, []string{bare.ID}},
+		{"all", []repo.LabelRequirementDTO{
+			label("team", repo.LabelEquals, "billing"), label("env", repo.LabelNotEquals, "prod"), label("tier", repo.LabelIn, "a", "b"),
+		}, []string{billing.ID}},
+	} {
+		jobs, err := r.List(ctx, &repo.JobsQueryDTO{Sort: repo.SortCreatedAt, Labels: tc.labels, Limit: 10})
+		if err != nil {
+			t.Fatalf("%s: list: %v", tc.name, err)
+		}
+		if got := jobIDs(jobs); !slices.Equal(got, tc.want) {
+			t.Errorf("%s = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func testListExecutionPages(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now + 60_000)
+	mustCreate(t, r, job)
+
+	// Три начатых выполнения и два в очереди, у которых нет времени начала
+	start := func(workerID string, startedAt int64) *repo.ExecutionDTO {
+		exec := newExecution(job.ID, repo.Running)
+		exec.WorkerID, exec.StartedAt = workerID, startedAt
+		if _, err := r.StartExecution(ctx, exec, nil, nil, nil); err != nil {
+			t.Fatalf("start execution: %v", err)
+		}
+		return exec
+	}
+	first, second, third := start("w1", now-3000), start("w2", now-2000), start("w1", now-1000)
+	queued := []string{mustStart(t, r, job.ID, nil).ID, mustStart(t, r, job.ID, nil).ID}
+	slices.Sort(queued)
+
+	// Страницы по два выполнения склеиваются в полный список без пропусков и повторов
+	pages := func(query repo.ExecutionsQueryDTO) []string {
+		t.Helper()
+		var ids []string
+		for range 5 {
+			page, err := r.ListExecutions(ctx, job.ID, &query)
+			if err != nil {
+				t.Fatalf("list executions: %v", err)
+			}
+			for _, e := range page {
+				ids = append(ids, e.ID)
+			}
+			if uint64(len(page)) < query.Limit {
+				return ids
+			}
+			last := page[len(page)-1]
+			query.After = &repo.CursorDTO{Key: last.SortKey(), ID: last.ID}
+		}
+		t.Fatalf("list executions did not end")
+		return nil
+	}
+
+	for _, tc := range []struct {
+		name  string
+		query repo.ExecutionsQueryDTO
+		want  []string
+	}{
+		{"started", repo.ExecutionsQueryDTO{}, []string{first.ID, second.ID, third.ID, queued[0], queued[1]}},
+		{"started desc", repo.ExecutionsQueryDTO{Desc: true}, []string{queued[1], queued[0], third.ID, second.ID, first.ID}},
+		{"worker", repo.ExecutionsQueryDTO{WorkerID: ptr("w1")}, []string{first.ID, third.ID}},
+		{"status", repo.ExecutionsQueryDTO{Status: ptr(string(repo.Queued))}, queued},
+		{"started range", repo.ExecutionsQueryDTO{StartedAfter: ptr(now - 2000), StartedBefore: ptr(now - 1000)}, []string{second.ID}},
+	} {
+		tc.query.Limit = 2
+		if got := pages(tc.query); !slices.Equal(got, tc.want) {
+			t.Errorf("%s = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func testListDue(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	late, early, future, paused := newJob(now-1000), newJob(now-2000), newJob(now+1000), newJob(now-3000)
+	for _, job := range []*repo.JobDTO{late, early, future, paused} {
+		mustCreate(t, r, job)
+	}
+	if err := r.PauseJob(ctx, paused.ID); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+
+	due, err := r.ListDue(ctx, now, nil, 10)
+	if err != nil {
+		t.Fatalf("list due: %v", err)
+	}
+	if want := []string{early.ID, late.ID}; !slices.Equal(jobIDs(due), want) {
+		t.Errorf("due = %v, want %v", jobIDs(due), want)
+	}
+	if due, _ = r.ListDue(ctx, now, nil, 1); len(due) != 1 || due[0].ID != early.ID {
+		t.Errorf("due with limit 1 = %v, want %s", jobIDs(due), early.ID)
+	}
+}
+
+func testStartExecution(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+
+	due, next := now, now+60_000
+	skipped := newExecution(job.ID, repo.Skipped)
+	exec := newExecution(job.ID, repo.Queued)
+	if _, err := r.StartExecution(ctx, exec, &due, &next, []repo.ExecutionDTO{*skipped}); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	got, _ := r.Read(ctx, job.ID)
+	if got.Status != repo.Running || deref(got.NextRunAt) != next {
+		t.Errorf("job after start: status %s, next run %d; want running, %d", got.Status, deref(got.NextRunAt), next)
+	}
+	if e, err := r.ReadExecution(ctx, skipped.ID); err != nil || e.Status != string(repo.Skipped) {
+		t.Errorf("skipped execution: %+v, %v", e, err)
+	}
+
+	// Тот же срок второй раз не запускается
+	if _, err := r.StartExecution(ctx, newExecution(job.ID, repo.Queued), &due, &next, nil); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("start with stale due time: %v, want ErrConflict", err)
+	}
+	// Ручной запуск не сдвигает расписание
+	if _, err := r.StartExecution(ctx, newExecution(job.ID, repo.Queued), nil, nil, nil); err != nil {
+		t.Errorf("manual start: %v", err)
+	}
+	if got, _ := r.Read(ctx, job.ID); deref(got.NextRunAt) != next {
+		t.Errorf("next run after manual start = %d, want %d", deref(got.NextRunAt), next)
+	}
+
+	if err := r.PauseJob(ctx, job.ID); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+	if _, err := r.StartExecution(ctx, newExecution(job.ID, repo.Queued), nil, nil, nil); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("start paused job: %v, want ErrConflict", err)
+	}
+	if _, err := r.StartExecution(ctx, newExecution(uuid.NewString(), repo.Queued), nil, nil, nil); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("start missing job: %v, want ErrNotFound", err)
+	}
+}
+
+func testStartExecutionForbid(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	job.ConcurrencyPolicy = string(entity.ConcurrencyForbid)
+	mustCreate(t, r, job)
+
+	mustStart(t, r, job.ID, nil)
+	if _, err := r.StartExecution(ctx, newExecution(job.ID, repo.Queued), nil, nil, nil); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("start running job with forbid policy: %v, want ErrConflict", err)
+	}
+}
+
+func testStartExecutionReplace(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	job.ConcurrencyPolicy = string(entity.ConcurrencyReplace)
+	mustCreate(t, r, job)
+
+	first := mustStart(t, r, job.ID, nil)
+	replaced, err := r.StartExecution(ctx, newExecution(job.ID, repo.Queued), nil, nil, nil)
+	if err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	if !slices.Equal(replaced, []string{first.ID}) {
+		t.Errorf("replaced = %v, want %s", replaced, first.ID)
+	}
+	if e, _ := r.ReadExecution(ctx, first.ID); e.Status != string(repo.Cancelled) || e.Reason != cases.ReasonReplaced {
+		t.Errorf("replaced execution: status %s, reason %s", e.Status, e.Reason)
+	}
+}
+
+func testSkipFires(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+
+	next := now + 60_000
+	if err := r.SkipFires(ctx, job.ID, now, &next, []repo.ExecutionDTO{*newExecution(job.ID, repo.Skipped)}); err != nil {
+		t.Fatalf("skip: %v", err)
+	}
+	if got, _ := r.Read(ctx, job.ID); deref(got.NextRunAt) != next || got.Status != repo.Queued {
+		t.Errorf("job after skip: next run %d, status %s", deref(got.NextRunAt), got.Status)
+	}
+	if err := r.SkipFires(ctx, job.ID, now, &next, nil); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("skip stale due time: %v, want ErrConflict", err)
+	}
+}
+
+func testLeaseExecutions(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job, httpJob, pausedJob := newJob(now), newJob(now), newJob(now)
+	job.Payload = map[string]any{"from": "job"}
+	httpJob.Type = string(entity.JobTypeHTTP)
+	for _, j := range []*repo.JobDTO{job, httpJob, pausedJob} {
+		mustCreate(t, r, j)
+	}
+
+	exec := mustStart(t, r, job.ID, map[string]any{"from": "trigger"})
+	mustStart(t, r, httpJob.ID, nil)
+	mustStart(t, r, pausedJob.ID, nil)
+	if err := r.PauseJob(ctx, pausedJob.ID); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+	future := newExecution(job.ID, repo.Queued)
+	future.ScheduledAt = now + 60_000
+	if _, err := r.StartExecution(ctx, future, nil, nil, nil); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+
+	leases, err := r.LeaseExecutions(ctx, "w1", []string{string(entity.JobTypeWorker)}, now, now+30_000, 10)
+	if err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+	if len(leases) != 1 || leases[0].Execution.ID != exec.ID {
+		t.Fatalf("leases = %+v, want only %s", leases, exec.ID)
+	}
+	l := leases[0]
+	if l.Execution.Status != string(repo.Running) || l.Execution.WorkerID != "w1" || l.Execution.StartedAt != now || l.Execution.LeaseExpiresAt != now+30_000 {
+		t.Errorf("leased execution = %+v", l.Execution)
+	}
+	if l.Job.ID != job.ID || l.Job.Payload["from"] != "trigger" || l.Execution.Payload["from"] != "trigger" {
+		t.Errorf("lease job %s payload %v, execution payload %v; want the payload of the trigger", l.Job.ID, l.Job.Payload, l.Execution.Payload)
+	}
+
+	if leases, _ = r.LeaseExecutions(ctx, "w2", []string{string(entity.JobTypeWorker)}, now, now+30_000, 10); len(leases) != 0 {
+		t.Errorf("second lease = %d executions, want 0", len(leases))
+	}
+	if leases, _ = r.LeaseExecutions(ctx, "engine", []string{string(entity.JobTypeHTTP)}, now, now+30_000, 10); len(leases) != 1 || leases[0].Job.ID != httpJob.ID {
+		t.Errorf("lease http = %+v, want the http job", leases)
+	}
+}
+
+func testLeaseExecutionsConcurrency(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	queue, allow := newJob(now), newJob(now)
+	queue.ConcurrencyPolicy = string(entity.ConcurrencyQueue)
+	mustCreate(t, r, queue)
+	mustCreate(t, r, allow)
+
+	// Задание с allow выдает оба выполнения сразу, с queue только раннее
+	first := newExecution(queue.ID, repo.Queued)
+	first.ScheduledAt = now - 1000
+	for _, e := range []*repo.ExecutionDTO{first, newExecution(queue.ID, repo.Queued), newExecution(allow.ID, repo.Queued), newExecution(allow.ID, repo.Queued)} {
+		if _, err := r.StartExecution(ctx, e, nil, nil, nil); err != nil {
+			t.Fatalf("start: %v", err)
+		}
+	}
+
+	leases, err := r.LeaseExecutions(ctx, "w1", []string{string(entity.JobTypeWorker)}, now, now+30_000, 10)
+	if err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+	var queueLeased, allowLeased []string
+	for _, l := range leases {
+		if l.Job.ID == queue.ID {
+			queueLeased = append(queueLeased, l.Execution.ID)
+		} else {
+			allowLeased = append(allowLeased, l.Execution.ID)
+		}
+	}
+	if !slices.Equal(queueLeased, []string{first.ID}) || len(allowLeased) != 2 {
+		t.Errorf("leased %v of the queue job and %v of the allow job; want %s and two", queueLeased, allowLeased, first.ID)
+	}
+	if leases, _ := r.LeaseExecutions(ctx, "w1", []string{string(entity.JobTypeWorker)}, now, now+30_000, 10); len(leases) != 0 {
+		t.Errorf("leased %d while the queue job runs, want 0", len(leases))
+	}
+}
+
+func testHeartbeat(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	timeout := int64(10_000)
+	job.Timeout = &timeout
+	mustCreate(t, r, job)
+	exec := mustStart(t, r, job.ID, nil)
+	leaseAll(t, r, "w1")
+
+	cancel, err := r.Heartbeat(ctx, exec.ID, "w1", now+1000, now+40_000)
+	if err != nil || cancel {
+		t.Errorf("heartbeat = %v, %v; want false, nil", cancel, err)
+	}
+	if cancel, _ := r.Heartbeat(ctx, exec.ID, "w1", now+timeout+1, now+50_000); !cancel {
+		t.Errorf("heartbeat after the timeout does not cancel")
+	}
+	if _, err := r.Heartbeat(ctx, exec.ID, "w2", now+1000, now+40_000); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("heartbeat of another worker: %v, want ErrConflict", err)
+	}
+	if _, err := r.Heartbeat(ctx, exec.ID, "w1", now+60_000, now+90_000); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("heartbeat after the lease expired: %v, want ErrConflict", err)
+	}
+	if _, err := r.Heartbeat(ctx, uuid.NewString(), "w1", now, now); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("heartbeat of missing execution: %v, want ErrNotFound", err)
+	}
+
+	finish(t, r, exec, repo.TimedOut, nil, nil)
+	if cancel, err := r.Heartbeat(ctx, exec.ID, "w1", now+1000, now+40_000); err != nil || !cancel {
+		t.Errorf("heartbeat of timed out execution = %v, %v; want true, nil", cancel, err)
+	}
+}
+
+func testFinishExecution(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+	exec := mustStart(t, r, job.ID, nil)
+	leaseAll(t, r, "w1")
+
+	wrong := *exec
+	wrong.WorkerID = "w2"
+	wrong.Status = string(repo.Completed)
+	if err := r.FinishExecution(ctx, &wrong, nil, nil, nil); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("finish by another worker: %v, want ErrConflict", err)
+	}
+
+	exec.Error = "boom"
+	exec.Output = "out"
+	finish(t, r, exec, repo.Failed, nil, nil)
+	got, _ := r.ReadExecution(ctx, exec.ID)
+	if got.Status != string(repo.Failed) || got.FinishedAt != now+1000 || got.Output != "out" || got.Error != "boom" {
+		t.Errorf("finished execution = %+v", got)
+	}
+	if j, _ := r.Read(ctx, job.ID); j.Status != repo.Failed || j.LastFinishedAt != now+1000 {
+		t.Errorf("job after finish: status %s, last finished %d", j.Status, j.LastFinishedAt)
+	}
+
+	if err := r.FinishExecution(ctx, exec, nil, nil, nil); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("finish twice: %v, want ErrConflict", err)
+	}
+	missing := *exec
+	missing.ID = uuid.NewString()
+	if err := r.FinishExecution(ctx, &missing, nil, nil, nil); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("finish missing execution: %v, want ErrNotFound", err)
+	}
+}
+
+func testFinishExecutionRetry(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+	exec := mustStart(t, r, job.ID, nil)
+	leaseAll(t, r, "w1")
+
+	retry := newExecution("", repo.Queued)
+	retry.Attempt = 2
+	finish(t, r, exec, repo.Failed, retry, nil)
+	if retry.JobID != job.ID {
+		t.Errorf("retry job ID = %q, want %s", retry.JobID, job.ID)
+	}
+	if j, _ := r.Read(ctx, job.ID); j.Status != repo.Running {
+		t.Errorf("job with a queued retry: status %s, want running", j.Status)
+	}
+	if e, err := r.ReadExecution(ctx, retry.ID); err != nil || e.Attempt != 2 || e.Status != string(repo.Queued) {
+		t.Errorf("retry = %+v, %v", e, err)
+	}
+}
+
+func testListTimedOut(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job, noTimeout := newJob(now), newJob(now)
+	timeout := int64(10_000)
+	job.Timeout = &timeout
+	mustCreate(t, r, job)
+	mustCreate(t, r, noTimeout)
+	exec := mustStart(t, r, job.ID, nil)
+	mustStart(t, r, noTimeout.ID, nil)
+	leaseAll(t, r, "w1")
+
+	if execs, _ := r.ListTimedOut(ctx, now+timeout, nil, 10); len(execs) != 0 {
+		t.Errorf("timed out at the deadline = %d, want 0", len(execs))
+	}
+	execs, err := r.ListTimedOut(ctx, now+timeout+1, nil, 10)
+	if err != nil {
+		t.Fatalf("list timed out: %v", err)
+	}
+	if len(execs) != 1 || execs[0].ID != exec.ID || execs[0].WorkerID != "w1" || execs[0].StartedAt != now {
+		t.Errorf("timed out = %+v, want %s", execs, exec.ID)
+	}
+}
+
+func testRequeueExecution(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+	exec := mustStart(t, r, job.ID, nil)
+	leaseAll(t, r, "w1")
+
+	if expired, _ := r.ListExpiredLeases(ctx, now+30_000, nil, 10); len(expired) != 0 {
+		t.Errorf("expired at the deadline = %d, want 0", len(expired))
+	}
+	expired, err := r.ListExpiredLeases(ctx, now+30_001, nil, 10)
+	if err != nil {
+		t.Fatalf("list expired: %v", err)
+	}
+	if len(expired) != 1 || expired[0].ID != exec.ID || expired[0].LeaseExpiresAt != now+30_000 {
+		t.Fatalf("expired = %+v, want %s", expired, exec.ID)
+	}
+
+	e := expired[0]
+	e.Status = string(repo.Failed)
+	e.Reason = "lease_expired"
+	e.FinishedAt = now + 30_001
+	next := newExecution(job.ID, repo.Queued)
+	next.Attempt = 2
+	if err := r.RequeueExecution(ctx, &e, next); err != nil {
+		t.Fatalf("requeue: %v", err)
+	}
+	if got, _ := r.ReadExecution(ctx, exec.ID); got.Status != string(repo.Failed) || got.Reason != "lease_expired" {
+		t.Errorf("expired execution = %+v", got)
+	}
+	if _, err := r.ReadExecution(ctx, next.ID); err != nil {
+		t.Errorf("requeued execution: %v", err)
+	}
+	if err := r.RequeueExecution(ctx, &e, newExecution(job.ID, repo.Queued)); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("requeue twice: %v, want ErrConflict", err)
+	}
+}
+
+func testPauseResume(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+
+	if err := r.ResumeJob(ctx, job.ID, nil); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("resume not paused job: %v, want ErrConflict", err)
+	}
+	if err := r.PauseJob(ctx, job.ID); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+	if err := r.PauseJob(ctx, job.ID); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("pause twice: %v, want ErrConflict", err)
+	}
+	policy := string(entity.MisfireSkip)
+	if err := r.ResumeJob(ctx, job.ID, &policy); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if got, _ := r.Read(ctx, job.ID); got.Status != repo.Queued || got.MisfirePolicy != policy {
+		t.Errorf("resumed job: status %s, misfire policy %s", got.Status, got.MisfirePolicy)
+	}
+
+	// Задание с незавершенным выполнением возобновляется как running
+	mustStart(t, r, job.ID, nil)
+	if err := r.PauseJob(ctx, job.ID); err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+	if err := r.ResumeJob(ctx, job.ID, nil); err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if got, _ := r.Read(ctx, job.ID); got.Status != repo.Running {
+		t.Errorf("resumed job with a queued execution: status %s, want running", got.Status)
+	}
+	if err := r.PauseJob(ctx, uuid.NewString()); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("pause missing job: %v, want ErrNotFound", err)
+	}
+}
+
+func testDeadLetters(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+
+	var ids []string
+	for i := range 2 {
+		exec := mustStart(t, r, job.ID, nil)
+		leaseAll(t, r, "w1")
+		d := &repo.DeadLetterDTO{ID: uuid.NewString(), ExecutionID: exec.ID, Attempts: 1, Reason: "boom", CreatedAt: now + int64(i)}
+		finish(t, r, exec, repo.Failed, nil, d)
+		ids = append(ids, d.ID)
+	}
+
+	deadLetters, err := r.ListDeadLetters(ctx)
+	if err != nil {
+		t.Fatalf("list dead letters: %v", err)
+	}
+	if len(deadLetters) != 2 || deadLetters[0].ID != ids[1] || deadLetters[0].JobID != job.ID || deadLetters[0].Reason != "boom" {
+		t.Fatalf("dead letters = %+v, want the latest first", deadLetters)
+	}
+
+	exec := newExecution("", repo.Queued)
+	if err := r.RequeueDeadLetter(ctx, ids[1], exec); err != nil {
+		t.Fatalf("requeue: %v", err)
+	}
+	if exec.JobID != job.ID {
+		t.Errorf("requeued execution job ID = %q, want %s", exec.JobID, job.ID)
+	}
+	if got, _ := r.Read(ctx, job.ID); got.Status != repo.Running {
+		t.Errorf("job after requeue: status %s, want running", got.Status)
+	}
+	// Задание уже выполняется, запись остается в очереди
+	if err := r.RequeueDeadLetter(ctx, ids[0], newExecution("", repo.Queued)); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("requeue while running: %v, want ErrConflict", err)
+	}
+	if err := r.RequeueDeadLetter(ctx, ids[1], newExecution("", repo.Queued)); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("requeue twice: %v, want ErrNotFound", err)
+	}
+
+	if err := r.DeleteDeadLetter(ctx, ids[0]); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := r.DeleteDeadLetter(ctx, ids[0]); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("delete twice: %v, want ErrNotFound", err)
+	}
+	if deadLetters, _ := r.ListDeadLetters(ctx); len(deadLetters) != 0 {
+		t.Errorf("dead letters left = %d, want 0", len(deadLetters))
+	}
+}
+
+func testWorkflows(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	workflow := &repo.WorkflowDTO{
+		ID:        uuid.NewString(),
+		Name:      "etl",
+		CreatedAt: now,
+		Nodes:     []repo.WorkflowNodeDTO{{Name: "a"}, {Name: "b", DependsOn: []string{"a"}}},
+	}
+	a, b := newJob(now), newJob(now)
+	for i, job := range []*repo.JobDTO{a, b} {
+		job.NextRunAt = nil
+		job.WorkflowID = &workflow.ID
+		workflow.Nodes[i].JobID = job.ID
+	}
+	if err := r.CreateWorkflow(ctx, workflow, []repo.JobDTO{*a, *b}); err != nil {
+		t.Fatalf("create workflow: %v", err)
+	}
+	got, err := r.ReadWorkflow(ctx, workflow.ID)
+	if err != nil {
+		t.Fatalf("read workflow: %v", err)
+	}
+	if got.Name != "etl" || len(got.Nodes) != 2 || !slices.Equal(got.Nodes[1].DependsOn, []string{"a"}) || got.Nodes[0].JobID != a.ID {
+		t.Errorf("workflow = %+v", got)
+	}
+	if job, err := r.Read(ctx, b.ID); err != nil || deref(job.WorkflowID) != workflow.ID {
+		t.Errorf("node job = %+v, %v", job, err)
+	}
+	if _, err := r.ReadWorkflow(ctx, uuid.NewString()); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("read missing workflow: %v, want ErrNotFound", err)
+	}
+
+	run := &repo.WorkflowRunDTO{ID: uuid.NewString(), WorkflowID: workflow.ID, Status: repo.Running, CreatedAt: now}
+	first := newExecution(a.ID, repo.Queued)
+	first.WorkflowRunID = run.ID
+	if err := r.StartWorkflowRun(ctx, run, []repo.ExecutionDTO{*first}); err != nil {
+		t.Fatalf("start run: %v", err)
+	}
+	if job, _ := r.Read(ctx, a.ID); job.Status != repo.Running {
+		t.Errorf("started node job: status %s, want running", job.Status)
+	}
+	active, err := r.ListActiveWorkflowRuns(ctx, nil, 10)
+	if err != nil || len(active) != 1 || active[0].ID != run.ID || active[0].Revision != 0 {
+		t.Fatalf("active runs = %+v, %v", active, err)
+	}
+
+	second := newExecution(b.ID, repo.Queued)
+	second.WorkflowRunID = run.ID
+	second.ScheduledAt = now + 1000
+	if err := r.AdvanceWorkflowRun(ctx, &active[0], []repo.ExecutionDTO{*second}); err != nil {
+		t.Fatalf("advance: %v", err)
+	}
+	if err := r.AdvanceWorkflowRun(ctx, &active[0], nil); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("advance stale revision: %v, want ErrConflict", err)
+	}
+	execs, err := r.ListWorkflowRunExecutions(ctx, run.ID)
+	if err != nil {
+		t.Fatalf("list run executions: %v", err)
+	}
+	if len(execs) != 2 || execs[0].ID != first.ID || execs[1].ID != second.ID || execs[1].WorkflowRunID != run.ID {
+		t.Errorf("run executions = %+v, want %s then %s", execs, first.ID, second.ID)
+	}
+
+	run, _ = r.ReadWorkflowRun(ctx, run.ID)
+	run.Status = repo.Completed
+	run.FinishedAt = now + 2000
+	if err := r.AdvanceWorkflowRun(ctx, run, nil); err != nil {
+		t.Fatalf("finish run: %v", err)
+	}
+	if got, _ := r.ReadWorkflowRun(ctx, run.ID); got.Status != repo.Completed || got.FinishedAt != now+2000 || got.Revision != 2 {
+		t.Errorf("finished run = %+v", got)
+	}
+	if active, _ := r.ListActiveWorkflowRuns(ctx, nil, 10); len(active) != 0 {
+		t.Errorf("active runs after finish = %d, want 0", len(active))
+	}
+	if _, err := r.ReadWorkflowRun(ctx, uuid.NewString()); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("read missing run: %v, want ErrNotFound", err)
+	}
+}
+
+func testExecutionLogs(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+	exec := mustStart(t, r, job.ID, nil)
+	leaseAll(t, r, "w1")
+
+	chunks := []repo.ExecutionLogDTO{{Stream: entity.LogStdout, Data: "a"}, {Stream: entity.LogStderr, Data: "b"}, {Stream: entity.LogStdout, Data: "c"}}
+	if err := r.AppendExecutionLogs(ctx, exec.ID, "w1", now, chunks); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+	if err := r.AppendExecutionLogs(ctx, exec.ID, "w2", now, chunks); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("append by another worker: %v, want ErrConflict", err)
+	}
+
+	logs, err := r.ListExecutionLogs(ctx, exec.ID, 0, 10)
+	if err != nil {
+		t.Fatalf("list logs: %v", err)
+	}
+	if len(logs) != 3 || logs[0].Data != "a" || logs[1].Stream != entity.LogStderr || logs[2].Data != "c" || logs[0].CreatedAt != now {
+		t.Fatalf("logs = %+v", logs)
+	}
+	if logs[0].Seq >= logs[1].Seq || logs[1].Seq >= logs[2].Seq {
+		t.Errorf("seq not increasing: %d, %d, %d", logs[0].Seq, logs[1].Seq, logs[2].Seq)
+	}
+	if after, _ := r.ListExecutionLogs(ctx, exec.ID, logs[0].Seq, 1); len(after) != 1 || after[0].Seq != logs[1].Seq {
+		t.Errorf("logs after the first with limit 1 = %+v", after)
+	}
+
+	finish(t, r, exec, repo.Completed, nil, nil)
+	if err := r.AppendExecutionLogs(ctx, exec.ID, "w1", now, chunks); !errors.Is(err, repo.ErrConflict) {
+		t.Errorf("append to finished execution: %v, want ErrConflict", err)
+	}
+	if err := r.AppendExecutionLogs(ctx, uuid.NewString(), "w1", now, chunks); !errors.Is(err, repo.ErrNotFound) {
+		t.Errorf("append to missing execution: %v, want ErrNotFound", err)
+	}
+}
+
+func testNotifications(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	job := newJob(now)
+	mustCreate(t, r, job)
+	exec := mustStart(t, r, job.ID, nil)
+	leaseAll(t, r, "w1")
+
+	notification := repo.NotificationDTO{
+		ID:            uuid.NewString(),
+		ExecutionID:   exec.ID,
+		Event:         string(entity.EventFailed),
+		URL:           "https://example.com/hook",
+		Secret:        "s",
+		Body:          []byte(`{"event":"failed"}`),
+		Status:        entity.DeliveryPending,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+	later := notification
+	later.ID = uuid.NewString()
+	later.CreatedAt = now + 1
+	later.NextAttemptAt = now + 60_000
+	finish(t, r, exec, repo.Failed, nil, nil, notification, later)
+
+	claimed, err := r.ClaimNotifications(ctx, now, now+60_000-1, 10)
+	if err != nil {
+		t.Fatalf("claim: %v", err)
+	}
+	if len(claimed) != 1 || claimed[0].ID != notification.ID || claimed[0].JobID != job.ID || claimed[0].Attempts != 1 ||
+		claimed[0].Secret != "s" || len(claimed[0].Body) == 0 {
+		t.Fatalf("claimed = %+v, want the due delivery", claimed)
+	}
+	if again, _ := r.ClaimNotifications(ctx, now, now+60_000, 10); len(again) != 0 {
+		t.Errorf("claimed delivery claimed again: %d", len(again))
+	}
+
+	d := claimed[0]
+	d.Status = entity.DeliveryDelivered
+	d.LastStatusCode = 204
+	d.DeliveredAt = now + 10
+	d.NextAttemptAt = 0
+	if err := r.FinishNotification(ctx, &d); err != nil {
+		t.Fatalf("finish notification: %v", err)
+	}
+
+	list, err := r.ListNotifications(ctx, job.ID)
+	if err != nil {
+		t.Fatalf("list notifications: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != later.ID || list[1].Status != entity.DeliveryDelivered || list[1].LastStatusCode != 204 || list[1].DeliveredAt != now+10 {
+		t.Errorf("notifications = %+v, want the latest first", list)
+	}
+	if claimed, _ := r.ClaimNotifications(ctx, now+60_000, now+120_000, 10); len(claimed) != 1 || claimed[0].ID != later.ID {
+		t.Errorf("claimed later = %+v, want %s", claimed, later.ID)
+	}
+}
+
+func testShards(t *testing.T, r cases.JobsRepo) {
+	ctx := context.Background()
+	var ids []string
+	for range 16 {
+		job := newJob(now)
+		mustCreate(t, r, job)
+		ids = append(ids, job.ID)
+	}
+
+	// Каждое задание принадлежит ровно одному шарду
+	seen := make(map[string]int)
+	for shard := range 4 {
+		due, err := r.ListDue(ctx, now, &repo.ShardsDTO{Count: 4, Owned: []int{shard}}, 100)
+		if err != nil {
+			t.Fatalf("list due of shard %d: %v", shard, err)
+		}
+		for _, job := range due {
+			seen[job.ID]++
+		}
+	}
+	for _, id := range ids {
+		if seen[id] != 1 {
+			t.Errorf("job %s is in %d shards, want 1", id, seen[id])
+		}
+	}
+	if due, _ := r.ListDue(ctx, now, &repo.ShardsDTO{Count: 4}, 100); len(due) != 0 {
+		t.Errorf("due without owned shards = %d, want 0", len(due))
+	}
+}
+
+func newJob(nextRunAt int64) *repo.JobDTO {
+	return &repo.JobDTO{
+		ID:                uuid.NewString(),
+		Type:              string(entity.JobTypeWorker),
+		Interval:          ptr("1m"),
+		Status:            repo.Queued,
+		CreatedAt:         now - 60_000,
+		NextRunAt:         &nextRunAt,
+		Payload:           map[string]any{},
+		Timezone:          "UTC",
+		MisfirePolicy:     string(entity.MisfireFireOnceNow),
+		ConcurrencyPolicy: string(entity.ConcurrencyAllow),
+	}
+}
+
+func newExecution(jobID string, status repo.Status) *repo.ExecutionDTO {
+	return &repo.ExecutionDTO{
+		ID:          uuid.NewString(),
+		JobID:       jobID,
+		Status:      string(status),
+		Attempt:     1,
+		Trigger:     string(entity.TriggerManual),
+		ScheduledAt: now,
+	}
+}
+
+func mustCreate(t *testing.T, r cases.JobsRepo, job *repo.JobDTO) {
+	t.Helper()
+	if err := r.Create(context.Background(), job); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+}
+
+// mustStart queues a manual execution of the job.
+func mustStart(t *testing.T, r cases.JobsRepo, jobID string, payload map[string]any) *repo.ExecutionDTO {
+	t.Helper()
+	exec := newExecution(jobID, repo.Queued)
+	exec.Payload = payload
+	if _, err := r.StartExecution(context.Background(), exec, nil, nil, nil); err != nil {
+		t.Fatalf("start execution: %v", err)
+	}
+	return exec
+}
+
+// leaseAll leases the due executions of worker jobs to the worker until now+30s.
+func leaseAll(t *testing.T, r cases.JobsRepo, workerID string) {
+	t.Helper()
+	if _, err := r.LeaseExecutions(context.Background(), workerID, []string{string(entity.JobTypeWorker)}, now, now+30_000, 100); err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+}
+
+// finish finishes the execution leased by leaseAll a second after now.
+func finish(t *testing.T, r cases.JobsRepo, exec *repo.ExecutionDTO, status repo.Status, retry *repo.ExecutionDTO, deadLetter *repo.DeadLetterDTO, notifications ...repo.NotificationDTO) {
+	t.Helper()
+	exec.WorkerID = "w1"
+	exec.Status = string(status)
+	exec.FinishedAt = now + 1000
+	if err := r.FinishExecution(context.Background(), exec, retry, deadLetter, notifications); err != nil {
+		t.Fatalf("finish execution: %v", err)
+	}
+}
+
+func jobIDs(jobs []repo.JobDTO) []string {
+	ids := make([]string, 0, len(jobs))
+	for _, j := range jobs {
+		ids = append(ids, j.ID)
+	}
+	return ids
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
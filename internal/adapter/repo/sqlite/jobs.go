@@ -0,0 +1,1182 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"scheduler/internal/cases"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"scheduler/pkg/utils/pointers"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"modernc.org/sqlite"
+)
+
+var _ cases.JobsRepo = (*JobsRepo)(nil)
+
+const (
+	createQuery = `
+		INSERT INTO jobs (id, once, interval, cron, status, created_at, last_finished_at, next_run_at, payload, timezone, retry_policy,
+			timeout_ms, misfire_policy, concurrency_policy, workflow_id, type, notifications, labels)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15, ?16, ?17, ?18)
+	`
+	readQuery = `
+		SELECT id, once, interval, cron, status, created_at, last_finished_at, next_run_at, payload, timezone, retry_policy,
+			timeout_ms, misfire_policy, concurrency_policy, workflow_id, type, notifications, labels
+		FROM jobs
+		WHERE id = ?1
+	`
+	deleteQuery = `DELETE FROM jobs WHERE id = ?1`
+
+	// Запуск по расписанию (?3 не NULL) сверяет next_run_at, чтобы один срок не запустился дважды,
+	// ручной запуск следующий запуск по расписанию не сдвигает
+	claimJobQuery = `
+		UPDATE jobs SET status = 'running', next_run_at = CASE WHEN ?3 IS NULL THEN next_run_at ELSE ?2 END
+		WHERE id = ?1 AND status <> 'paused' AND (?3 IS NULL OR next_run_at = ?3)
+			AND (status <> 'running' OR concurrency_policy <> 'forbid')
+		RETURNING concurrency_policy
+	`
+	cancelActiveQuery = `
+		UPDATE executions SET status = 'cancelled', reason = ?2, finished_at = ?3
+		WHERE job_id = ?1 AND status IN ('queued', 'running')
+		RETURNING id
+	`
+	// Сравнение со считанным next_run_at защищает от повторного пропуска тех же запусков
+	skipFiresQuery = `
+		UPDATE jobs SET next_run_at = ?2
+		WHERE id = ?1 AND next_run_at = ?3 AND status <> 'paused'
+	`
+	createExecutionQuery = `
+		INSERT INTO executions (id, job_id, worker_id, status, attempt, scheduled_at, started_at, reason, trigger, payload,
+			workflow_run_id)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11)
+	`
+	finishExecutionQuery = `
+		UPDATE executions SET status = ?2, finished_at = ?3, output = ?4, reason = ?6, error = ?7
+		WHERE id = ?1 AND worker_id = ?5 AND status = 'running'
+		RETURNING job_id
+	`
+	// Приостановленное задание остается приостановленным, когда завершается начатое до паузы выполнение,
+	// а задание с другими незавершенными выполнениями остается running
+	finishJobQuery = `
+		UPDATE jobs SET status = CASE
+			WHEN status = 'paused' THEN status
+			WHEN EXISTS (SELECT 1 FROM executions WHERE job_id = ?1 AND status IN ('queued', 'running')) THEN 'running'
+			ELSE ?2
+		END, last_finished_at = ?3
+		WHERE id = ?1
+	`
+	executionExistsQuery = `SELECT EXISTS (SELECT 1 FROM executions WHERE id = ?1)`
+	jobExistsQuery       = `SELECT EXISTS (SELECT 1 FROM jobs WHERE id = ?1)`
+	readExecutionQuery   = `
+		SELECT id, job_id, COALESCE(worker_id, ''), status, attempt, COALESCE(scheduled_at, 0),
+			COALESCE(started_at, 0), COALESCE(finished_at, 0), COALESCE(output, ''), COALESCE(reason, ''), trigger, payload,
+			COALESCE(workflow_run_id, ''), COALESCE(error, ''), COALESCE(lease_expires_at, 0)
+		FROM executions
+		WHERE id = ?1
+	`
+
+	// Без SKIP LOCKED выдача выполняется в транзакции BEGIN IMMEDIATE: она сразу берет блокировку записи,
+	// поэтому выбранные выполнения не выдаст никто другой. Кроме заданий с политикой allow выдается
+	// только самое раннее выполнение задания и только когда у него нет выполняющихся
+	leaseQuery = `
+		UPDATE executions
+		SET worker_id = ?1, status = 'running', started_at = ?2, heartbeat_at = ?2, lease_expires_at = ?4
+		WHERE id IN (
+			SELECT e.id FROM executions e
+			JOIN jobs j ON j.id = e.job_id
+			WHERE e.status = 'queued' AND e.scheduled_at <= ?2 AND j.status <> 'paused'
+				AND j.type IN (SELECT value FROM json_each(?5))
+				AND (j.concurrency_policy = 'allow' OR (
+					NOT EXISTS (SELECT 1 FROM executions r WHERE r.job_id = e.job_id AND r.status = 'running')
+					AND e.id = (
+						SELECT q.id FROM executions q
+						WHERE q.job_id = e.job_id AND q.status = 'queued' AND q.scheduled_at <= ?2
+						ORDER BY q.scheduled_at, q.id
+						LIMIT 1
+					)
+				))
+			ORDER BY e.scheduled_at
+			LIMIT ?3
+		)
+		RETURNING id
+	`
+	readLeasesQuery = `
+		SELECT e.id, e.job_id, e.worker_id, e.status, e.attempt, e.trigger, e.payload, COALESCE(e.workflow_run_id, ''),
+			e.scheduled_at, e.started_at, e.lease_expires_at,
+			j.id, j.once, j.interval, j.cron, j.status, j.created_at, j.last_finished_at, j.next_run_at,
+			COALESCE(e.payload, j.payload), j.timezone,
+			j.retry_policy, j.timeout_ms, j.misfire_policy, j.concurrency_policy, j.workflow_id, j.type,
+			j.notifications, j.labels
+		FROM executions e
+		JOIN jobs j ON j.id = e.job_id
+		WHERE e.id IN (SELECT value FROM json_each(?1))
+		ORDER BY e.scheduled_at, e.id
+	`
+	// Вместе с продлением аренды сообщает, истек ли таймаут задания
+	heartbeatQuery = `
+		UPDATE executions SET heartbeat_at = ?3, lease_expires_at = ?4
+		WHERE id = ?1 AND worker_id = ?2 AND status = 'running' AND lease_expires_at >= ?3
+		RETURNING COALESCE((
+			SELECT j.timeout_ms IS NOT NULL AND executions.started_at + j.timeout_ms < ?3
+			FROM jobs j
+			WHERE j.id = executions.job_id
+		), 0)
+	`
+	executionStateQuery = `SELECT status, COALESCE(worker_id, '') FROM executions WHERE id = ?1`
+	listTimedOutQuery   = `
+		SELECT e.id, e.job_id, COALESCE(e.worker_id, ''), e.attempt, e.trigger, e.payload, COALESCE(e.workflow_run_id, ''),
+			e.started_at
+		FROM executions e
+		JOIN jobs j ON j.id = e.job_id
+		WHERE e.status = 'running' AND j.timeout_ms IS NOT NULL AND e.started_at + j.timeout_ms < ?1
+			AND (?3 IS NULL OR (hashtext(e.job_id) & 2147483647) % ?3 IN (SELECT value FROM json_each(?4)))
+		ORDER BY e.started_at
+		LIMIT ?2
+	`
+	listExpiredLeasesQuery = `
+		SELECT id, job_id, worker_id, attempt, trigger, payload, COALESCE(workflow_run_id, ''), lease_expires_at
+		FROM executions
+		WHERE status = 'running' AND lease_expires_at < ?1
+			AND (?3 IS NULL OR (hashtext(job_id) & 2147483647) % ?3 IN (SELECT value FROM json_each(?4)))
+		ORDER BY lease_expires_at
+		LIMIT ?2
+	`
+	// Срок аренды сравнивается с прочитанным: heartbeat между чтением и обновлением отменяет возврат в очередь
+	expireLeaseQuery = `
+		UPDATE executions SET status = ?2, reason = ?3, finished_at = ?4
+		WHERE id = ?1 AND status = 'running' AND lease_expires_at = ?5
+	`
+
+	createDeadLetterQuery = `
+		INSERT INTO dead_letter (id, job_id, execution_id, attempts, reason, output, created_at)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7)
+	`
+	listDeadLettersQuery = `
+		SELECT id, job_id, execution_id, attempts, COALESCE(reason, ''), COALESCE(output, ''), created_at
+		FROM dead_letter
+		ORDER BY created_at DESC
+	`
+	deleteDeadLetterQuery  = `DELETE FROM dead_letter WHERE id = ?1`
+	requeueDeadLetterQuery = `DELETE FROM dead_letter WHERE id = ?1 RETURNING job_id`
+	// В отличие от claimJobQuery не сдвигает следующий запуск по расписанию
+	rerunJobQuery = `
+		UPDATE jobs SET status = ?2
+		WHERE id = ?1 AND status NOT IN (?2, 'paused')
+	`
+
+	pauseJobQuery = `
+		UPDATE jobs SET status = 'paused'
+		WHERE id = ?1 AND status <> 'paused'
+	`
+	// Задание с незавершенными выполнениями снова становится running, чтобы расписание не запустило второе
+	resumeJobQuery = `
+		UPDATE jobs SET misfire_policy = COALESCE(?2, misfire_policy), status = CASE
+			WHEN EXISTS (SELECT 1 FROM executions WHERE job_id = ?1 AND status IN ('queued', 'running')) THEN 'running'
+			ELSE 'queued'
+		END
+		WHERE id = ?1 AND status = 'paused'
+	`
+)
+
+var jobColumns = []string{
+	"id", "once", "interval", "cron", "status", "created_at", "last_finished_at", "next_run_at", "payload", "timezone",
+	"retry_policy", "timeout_ms", "misfire_policy", "concurrency_policy", "workflow_id", "type",
+	"notifications", "labels",
+}
+
+func init() {
+	// hashtext распределяет задания по шардам, как одноименная функция Postgres, но со своим хешем
+	sqlite.MustRegisterDeterministicScalarFunction("hashtext", 1, func(ctx *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("hashtext: unexpected argument %T", args[0])
+		}
+		h := fnv.New32a()
+		h.Write([]byte(s))
+		return int64(int32(h.Sum32())), nil
+	})
+}
+
+// querier is implemented by both the database and transactions.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// JobsRepo keeps the data in an SQLite database file for single-node deployments.
+//
+// The database is used through a single connection: SQLite serializes writers anyway,
+// and transactions begin with BEGIN IMMEDIATE, so a transaction which reads rows to update
+// them holds the write lock from its start. This gives the claim semantics the Postgres
+// repository gets from FOR UPDATE SKIP LOCKED.
+type JobsRepo struct {
+	db *sql.DB
+}
+
+// NewJobsRepo opens the database at path, ":memory:" opens a private in-memory database.
+func NewJobsRepo(path string) (*JobsRepo, error) {
+	db, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &JobsRepo{db: db}, nil
+}
+
+// Open opens the database at path with the settings the repository relies on, for migrations too.
+func Open(path string) (*sql.DB, error) {
+	dsn := "file:" + path + "?_txlock=immediate&_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	// Единственное соединение: запросы вне транзакции ждут ее завершения, а база в памяти не теряется
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxIdleTime(0)
+	db.SetConnMaxLifetime(0)
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// DB returns the database of the repository, e.g. to migrate it.
+func (r *JobsRepo) DB() *sql.DB {
+	return r.db
+}
+
+func (r *JobsRepo) Close() {
+	r.db.Close()
+}
+
+func (r *JobsRepo) Create(ctx context.Context, job *repo.JobDTO) error {
+	return insertJob(ctx, r.db, job)
+}
+
+func (r *JobsRepo) Read(ctx context.Context, jobID string) (*repo.JobDTO, error) {
+	job, err := scanJob(r.db.QueryRowContext(ctx, readQuery, jobID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func (r *JobsRepo) Delete(ctx context.Context, jobID string) error {
+	res, err := r.db.ExecContext(ctx, deleteQuery, jobID)
+	if err != nil {
+		return err
+	}
+	return notFoundIfNone(res)
+}
+
+// List returns up to query.Limit jobs after query.After in the order of the sort column and then of the ID.
+func (r *JobsRepo) List(ctx context.Context, query *repo.JobsQueryDTO) ([]repo.JobDTO, error) {
+	qb := squirrel.Select(jobColumns...).
+		From("jobs")
+
+	if query.Status != nil {
+		qb = qb.Where(squirrel.Eq{"status": *query.Status})
+	}
+	if query.Schedule != "" {
+		qb = qb.Where(squirrel.NotEq{query.Schedule: nil})
+	}
+	if query.CreatedAfter != nil {
+		qb = qb.Where(squirrel.GtOrEq{"created_at": *query.CreatedAfter})
+	}
+	if query.CreatedBefore != nil {
+		qb = qb.Where(squirrel.Lt{"created_at": *query.CreatedBefore})
+	}
+	for _, req := range query.Labels {
+		cond, err := labelCondition(req)
+		if err != nil {
+			return nil, err
+		}
+		qb = qb.Where(cond)
+	}
+
+	// Задания без следующего запуска идут после остальных, как будто он бесконечно далек
+	key := query.Sort
+	if key == repo.SortNextRunAt {
+		key = fmt.Sprintf("COALESCE(next_run_at, %d)", repo.SortLast)
+	}
+	op, dir := ">", "ASC"
+	if query.Desc {
+		op, dir = "<", "DESC"
+	}
+	if query.After != nil {
+		qb = qb.Where(fmt.Sprintf("(%s, id) %s (?, ?)", key, op), query.After.Key, query.After.ID)
+	}
+
+	sqlQuery, args, err := qb.OrderBy(key+" "+dir, "id "+dir).
+		Limit(query.Limit).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectJobs(rows)
+}
+
+// ListExecutions returns up to query.Limit executions of the job after query.After
+// in the order of the start time and then of the ID.
+func (r *JobsRepo) ListExecutions(ctx context.Context, jobID string, query *repo.ExecutionsQueryDTO) ([]repo.ExecutionDTO, error) {
+	qb := squirrel.Select(
+		"id", "job_id", "COALESCE(worker_id, '')", "status", "attempt", "trigger", "COALESCE(scheduled_at, 0)",
+		"COALESCE(started_at, 0)", "COALESCE(finished_at, 0)", "COALESCE(output, '')", "COALESCE(reason, '')",
+		"COALESCE(error, '')",
+	).From("executions").
+		Where(squirrel.Eq{"job_id": jobID})
+
+	if query.WorkerID != nil {
+		qb = qb.Where(squirrel.Eq{"worker_id": *query.WorkerID})
+	}
+	if query.Status != nil {
+		qb = qb.Where(squirrel.Eq{"status": *query.Status})
+	}
+	if query.StartedAfter != nil {
+		qb = qb.Where(squirrel.GtOrEq{"started_at": *query.StartedAfter})
+	}
+	if query.StartedBefore != nil {
+		qb = qb.Where(squirrel.Lt{"started_at": *query.StartedBefore})
+	}
+	if query.After != nil {
+		qb = qb.Where(startedAfterCursor(query.After, query.Desc))
+	}
+
+	// Невыполнявшиеся идут после остальных: в SQLite NULL по умолчанию меньше любого значения
+	if query.Desc {
+		qb = qb.OrderBy("started_at DESC NULLS FIRST", "id DESC")
+	} else {
+		qb = qb.OrderBy("started_at ASC NULLS LAST", "id ASC")
+	}
+	qb = qb.Limit(query.Limit)
+
+	sqlQuery, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var execs []repo.ExecutionDTO
+	for rows.Next() {
+		var e repo.ExecutionDTO
+		if err := rows.Scan(
+			&e.ID,
+			&e.JobID,
+			&e.WorkerID,
+			&e.Status,
+			&e.Attempt,
+			&e.Trigger,
+			&e.ScheduledAt,
+			&e.StartedAt,
+			&e.FinishedAt,
+			&e.Output,
+			&e.Reason,
+			&e.Error,
+		); err != nil {
+			return nil, err
+		}
+		execs = append(execs, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return execs, nil
+}
+
+// ListDue returns jobs whose next run time is not after now and which are not paused.
+// Running jobs are returned too, their concurrency policy decides about the run.
+// Non-nil shards restricts the jobs to the owned shards.
+func (r *JobsRepo) ListDue(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.JobDTO, error) {
+	qb := squirrel.Select(jobColumns...).
+		From("jobs").
+		Where(squirrel.LtOrEq{"next_run_at": now}).
+		Where(squirrel.NotEq{"status": repo.Paused})
+
+	if shards != nil {
+		count, owned := shardArgs(shards)
+		qb = qb.Where("(hashtext(id) & 2147483647) % ? IN (SELECT value FROM json_each(?))", count, owned)
+	}
+
+	query, args, err := qb.OrderBy("next_run_at", "id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return collectJobs(rows)
+}
+
+// StartExecution marks the job as running and records the execution together with the skipped ones.
+// An execution without a worker is queued until a worker leases it.
+// For a scheduled run dueAt is the next run time of the job, which is moved to nextRunAt;
+// a manual run with nil dueAt does not move it.
+// Active executions of a job with the replace policy are cancelled, their IDs are returned.
+// It returns repo.ErrNotFound if there is no such job and repo.ErrConflict if the job is paused,
+// has been fired meanwhile or is running and forbids concurrent runs.
+func (r *JobsRepo) StartExecution(ctx context.Context, exec *repo.ExecutionDTO, dueAt *int64, nextRunAt *int64, skipped []repo.ExecutionDTO) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var policy string
+	err = tx.QueryRowContext(ctx, claimJobQuery, exec.JobID, nextRunAt, dueAt).Scan(&policy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, conflict(ctx, tx, jobExistsQuery, exec.JobID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var replaced []string
+	if policy == string(entity.ConcurrencyReplace) {
+		if replaced, err = collectIDs(tx.QueryContext(ctx, cancelActiveQuery, exec.JobID, cases.ReasonReplaced, exec.ScheduledAt)); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range skipped {
+		if err := insertExecution(ctx, tx, &skipped[i]); err != nil {
+			return nil, err
+		}
+	}
+	if err := insertExecution(ctx, tx, exec); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return replaced, nil
+}
+
+// SkipFires moves the next run time of the job due at dueAt without running it
+// and records the skipped executions.
+// It returns repo.ErrConflict if the job has been fired, paused or skipped meanwhile.
+func (r *JobsRepo) SkipFires(ctx context.Context, jobID string, dueAt int64, nextRunAt *int64, skipped []repo.ExecutionDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, skipFiresQuery, jobID, nextRunAt, dueAt)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repo.ErrConflict
+	}
+
+	for i := range skipped {
+		if err := insertExecution(ctx, tx, &skipped[i]); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// FinishExecution stores the final status of the execution held by exec.WorkerID.
+// Without retry the job gets the same status, otherwise retry is queued and the job stays running.
+// A non-nil deadLetter is recorded for the job and notifications are queued in the same transaction.
+// It returns repo.ErrConflict if the execution is not running or is held by another worker.
+func (r *JobsRepo) FinishExecution(ctx context.Context, exec *repo.ExecutionDTO, retry *repo.ExecutionDTO, deadLetter *repo.DeadLetterDTO, notifications []repo.NotificationDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, finishExecutionQuery,
+		exec.ID,
+		exec.Status,
+		exec.FinishedAt,
+		exec.Output,
+		exec.WorkerID,
+		pointers.NilIfEmpty(exec.Reason),
+		pointers.NilIfEmpty(exec.Error),
+	).Scan(&exec.JobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return conflict(ctx, tx, executionExistsQuery, exec.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if retry != nil {
+		retry.JobID = exec.JobID
+		if err := insertExecution(ctx, tx, retry); err != nil {
+			return err
+		}
+	} else if _, err := tx.ExecContext(ctx, finishJobQuery, exec.JobID, exec.Status, exec.FinishedAt); err != nil {
+		return err
+	}
+
+	if deadLetter != nil {
+		deadLetter.JobID = exec.JobID
+		if _, err := tx.ExecContext(ctx, createDeadLetterQuery,
+			deadLetter.ID,
+			deadLetter.JobID,
+			deadLetter.ExecutionID,
+			deadLetter.Attempts,
+			pointers.NilIfEmpty(deadLetter.Reason),
+			pointers.NilIfEmpty(deadLetter.Output),
+			deadLetter.CreatedAt,
+		); err != nil {
+			return err
+		}
+	}
+	for i := range notifications {
+		notifications[i].JobID = exec.JobID
+	}
+	if err := insertNotifications(ctx, tx, notifications); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ReadExecution returns the execution by its ID.
+func (r *JobsRepo) ReadExecution(ctx context.Context, execID string) (*repo.ExecutionDTO, error) {
+	var e repo.ExecutionDTO
+	var payloadBytes []byte
+	err := r.db.QueryRowContext(ctx, readExecutionQuery, execID).Scan(
+		&e.ID,
+		&e.JobID,
+		&e.WorkerID,
+		&e.Status,
+		&e.Attempt,
+		&e.ScheduledAt,
+		&e.StartedAt,
+		&e.FinishedAt,
+		&e.Output,
+		&e.Reason,
+		&e.Trigger,
+		&payloadBytes,
+		&e.WorkflowRunID,
+		&e.Error,
+		&e.LeaseExpiresAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := unmarshalNullable(payloadBytes, &e.Payload); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// LeaseExecutions hands up to limit queued executions of the jobs of the given types over to the worker until leaseExpiresAt.
+func (r *JobsRepo) LeaseExecutions(ctx context.Context, workerID string, types []string, now int64, leaseExpiresAt int64, limit uint64) ([]repo.LeaseDTO, error) {
+	typesJSON, err := json.Marshal(types)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	ids, err := collectIDs(tx.QueryContext(ctx, leaseQuery, workerID, now, limit, leaseExpiresAt, string(typesJSON)))
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, tx.Commit()
+	}
+	idsJSON, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, readLeasesQuery, string(idsJSON))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var leases []repo.LeaseDTO
+	for rows.Next() {
+		var l repo.LeaseDTO
+		var execPayloadBytes, payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes []byte
+		if err := rows.Scan(
+			&l.Execution.ID,
+			&l.Execution.JobID,
+			&l.Execution.WorkerID,
+			&l.Execution.Status,
+			&l.Execution.Attempt,
+			&l.Execution.Trigger,
+			&execPayloadBytes,
+			&l.Execution.WorkflowRunID,
+			&l.Execution.ScheduledAt,
+			&l.Execution.StartedAt,
+			&l.Execution.LeaseExpiresAt,
+			&l.Job.ID,
+			&l.Job.Once,
+			&l.Job.Interval,
+			&l.Job.Cron,
+			&l.Job.Status,
+			&l.Job.CreatedAt,
+			&l.Job.LastFinishedAt,
+			&l.Job.NextRunAt,
+			&payloadBytes,
+			&l.Job.Timezone,
+			&retryPolicyBytes,
+			&l.Job.Timeout,
+			&l.Job.MisfirePolicy,
+			&l.Job.ConcurrencyPolicy,
+			&l.Job.WorkflowID,
+			&l.Job.Type,
+			&notificationsBytes,
+			&labelsBytes,
+		); err != nil {
+			return nil, err
+		}
+		if err := fillJob(&l.Job, payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes); err != nil {
+			return nil, err
+		}
+		if err := unmarshalNullable(execPayloadBytes, &l.Execution.Payload); err != nil {
+			return nil, err
+		}
+		leases = append(leases, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return leases, nil
+}
+
+// Heartbeat records that the worker is still running the execution and extends its lease.
+// It reports whether the worker should cancel the execution because it exceeded the job timeout.
+// It returns repo.ErrConflict if the execution is not running, its lease has expired
+// or it is held by another worker.
+func (r *JobsRepo) Heartbeat(ctx context.Context, execID string, workerID string, now int64, leaseExpiresAt int64) (bool, error) {
+	var cancel bool
+	err := r.db.QueryRowContext(ctx, heartbeatQuery, execID, workerID, now, leaseExpiresAt).Scan(&cancel)
+	if errors.Is(err, sql.ErrNoRows) {
+		return r.heartbeatConflict(ctx, execID, workerID)
+	}
+	if err != nil {
+		return false, err
+	}
+	return cancel, nil
+}
+
+// heartbeatConflict explains why a heartbeat matched no rows.
+// The worker holding a timed out or replaced execution is told to cancel it.
+func (r *JobsRepo) heartbeatConflict(ctx context.Context, execID string, workerID string) (bool, error) {
+	var status, holder string
+	err := r.db.QueryRowContext(ctx, executionStateQuery, execID).Scan(&status, &holder)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, repo.ErrNotFound
+	}
+	if err != nil {
+		return false, err
+	}
+	if (status == string(repo.TimedOut) || status == string(repo.Cancelled)) && holder == workerID {
+		return true, nil
+	}
+	return false, repo.ErrConflict
+}
+
+// ListTimedOut returns running executions which exceeded the timeout of their jobs.
+// Non-nil shards restricts the executions to the jobs of the owned shards.
+func (r *JobsRepo) ListTimedOut(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.ExecutionDTO, error) {
+	count, owned := shardArgs(shards)
+	rows, err := r.db.QueryContext(ctx, listTimedOutQuery, now, limit, count, owned)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var execs []repo.ExecutionDTO
+	for rows.Next() {
+		var e repo.ExecutionDTO
+		var payloadBytes []byte
+		if err := rows.Scan(&e.ID, &e.JobID, &e.WorkerID, &e.Attempt, &e.Trigger, &payloadBytes, &e.WorkflowRunID, &e.StartedAt); err != nil {
+			return nil, err
+		}
+		if err := unmarshalNullable(payloadBytes, &e.Payload); err != nil {
+			return nil, err
+		}
+		execs = append(execs, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return execs, nil
+}
+
+// ListExpiredLeases returns running executions whose lease deadline is before now.
+// Non-nil shards restricts the executions to the jobs of the owned shards.
+func (r *JobsRepo) ListExpiredLeases(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.ExecutionDTO, error) {
+	count, owned := shardArgs(shards)
+	rows, err := r.db.QueryContext(ctx, listExpiredLeasesQuery, now, limit, count, owned)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var execs []repo.ExecutionDTO
+	for rows.Next() {
+		var e repo.ExecutionDTO
+		var payloadBytes []byte
+		if err := rows.Scan(&e.ID, &e.JobID, &e.WorkerID, &e.Attempt, &e.Trigger, &payloadBytes, &e.WorkflowRunID, &e.LeaseExpiresAt); err != nil {
+			return nil, err
+		}
+		if err := unmarshalNullable(payloadBytes, &e.Payload); err != nil {
+			return nil, err
+		}
+		execs = append(execs, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return execs, nil
+}
+
+// RequeueExecution closes the expired execution and queues next in its place.
+// It returns repo.ErrConflict if the lease was extended or the execution finished meanwhile.
+func (r *JobsRepo) RequeueExecution(ctx context.Context, expired *repo.ExecutionDTO, next *repo.ExecutionDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, expireLeaseQuery,
+		expired.ID,
+		expired.Status,
+		expired.Reason,
+		expired.FinishedAt,
+		expired.LeaseExpiresAt,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repo.ErrConflict
+	}
+
+	if err := insertExecution(ctx, tx, next); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PauseJob stops firing the job and leasing its queued executions.
+// It returns repo.ErrConflict if the job is paused already.
+func (r *JobsRepo) PauseJob(ctx context.Context, jobID string) error {
+	return r.updateJob(ctx, jobID, pauseJobQuery, jobID)
+}
+
+// ResumeJob lets the paused job fire again, replacing its misfire policy when one is given.
+// It returns repo.ErrConflict if the job is not paused.
+func (r *JobsRepo) ResumeJob(ctx context.Context, jobID string, misfirePolicy *string) error {
+	return r.updateJob(ctx, jobID, resumeJobQuery, jobID, misfirePolicy)
+}
+
+// ListDeadLetters returns dead letters, the most recent first.
+func (r *JobsRepo) ListDeadLetters(ctx context.Context) ([]repo.DeadLetterDTO, error) {
+	rows, err := r.db.QueryContext(ctx, listDeadLettersQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deadLetters []repo.DeadLetterDTO
+	for rows.Next() {
+		var d repo.DeadLetterDTO
+		if err := rows.Scan(&d.ID, &d.JobID, &d.ExecutionID, &d.Attempts, &d.Reason, &d.Output, &d.CreatedAt); err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deadLetters, nil
+}
+
+// RequeueDeadLetter removes the dead letter and queues exec for its job, which becomes running again.
+// It returns repo.ErrNotFound if there is no such dead letter
+// and repo.ErrConflict if the job is running meanwhile.
+func (r *JobsRepo) RequeueDeadLetter(ctx context.Context, deadLetterID string, exec *repo.ExecutionDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, requeueDeadLetterQuery, deadLetterID).Scan(&exec.JobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repo.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx, rerunJobQuery, exec.JobID, repo.Running)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repo.ErrConflict
+	}
+
+	if err := insertExecution(ctx, tx, exec); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteDeadLetter removes the dead letter, the job and its executions are kept.
+func (r *JobsRepo) DeleteDeadLetter(ctx context.Context, deadLetterID string) error {
+	res, err := r.db.ExecContext(ctx, deleteDeadLetterQuery, deadLetterID)
+	if err != nil {
+		return err
+	}
+	return notFoundIfNone(res)
+}
+
+// updateJob runs an update of the job guarded by its status and explains why it matched no rows.
+func (r *JobsRepo) updateJob(ctx context.Context, jobID string, query string, args ...any) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return conflict(ctx, tx, jobExistsQuery, jobID)
+	}
+	return tx.Commit()
+}
+
+// conflict explains why an update guarded by the status of a row matched no rows.
+// It takes the transaction of the update: the only connection is busy until it ends.
+func conflict(ctx context.Context, q querier, existsQuery string, id string) error {
+	var exists bool
+	if err := q.QueryRowContext(ctx, existsQuery, id).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return repo.ErrNotFound
+	}
+	return repo.ErrConflict
+}
+
+func notFoundIfNone(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repo.ErrNotFound
+	}
+	return nil
+}
+
+// labelCondition translates a label requirement into SQL over the JSON object of labels.
+func labelCondition(req repo.LabelRequirementDTO) (squirrel.Sqlizer, error) {
+	values, err := json.Marshal(req.Values)
+	if err != nil {
+		return nil, err
+	}
+	const (
+		hasValue = "EXISTS (SELECT 1 FROM json_each(labels) WHERE key = ? AND value IN (SELECT value FROM json_each(?)))"
+		hasKey   = "EXISTS (SELECT 1 FROM json_each(labels) WHERE key = ?)"
+	)
+
+	switch req.Op {
+	case repo.LabelEquals, repo.LabelIn:
+		return squirrel.Expr(hasValue, req.Key, string(values)), nil
+	case repo.LabelNotEquals, repo.LabelNotIn:
+		return squirrel.Expr("NOT "+hasValue, req.Key, string(values)), nil
+	case repo.LabelExists:
+		return squirrel.Expr(hasKey, req.Key), nil
+	case repo.LabelNotExists:
+		return squirrel.Expr("NOT "+hasKey, req.Key), nil
+	}
+	return nil, fmt.Errorf("unknown label operator %q", req.Op)
+}
+
+// startedAfterCursor selects the executions which follow the cursor in the order of the start time.
+// The executions which have not started have no start time and come last in ascending order.
+func startedAfterCursor(after *repo.CursorDTO, desc bool) squirrel.Sqlizer {
+	switch {
+	case after.Key == repo.SortLast && !desc:
+		return squirrel.Expr("(started_at IS NULL AND id > ?)", after.ID)
+	case after.Key == repo.SortLast:
+		return squirrel.Expr("(started_at IS NOT NULL OR id < ?)", after.ID)
+	case !desc:
+		return squirrel.Expr("(started_at > ? OR (started_at = ? AND id > ?) OR started_at IS NULL)", after.Key, after.Key, after.ID)
+	default:
+		return squirrel.Expr("(started_at < ? OR (started_at = ? AND id < ?))", after.Key, after.Key, after.ID)
+	}
+}
+
+// shardArgs returns the parameters of a query filtering by shard: NULL without shards
+// and the owned shards as a JSON array.
+func shardArgs(shards *repo.ShardsDTO) (*int, string) {
+	if shards == nil {
+		return nil, "[]"
+	}
+	owned, _ := json.Marshal(shards.Owned)
+	if shards.Owned == nil {
+		owned = []byte("[]")
+	}
+	return &shards.Count, string(owned)
+}
+
+// insertJob stores the job through the database or within a transaction.
+// Once is stored as an RFC 3339 instant in UTC, so that it reads back as from Postgres.
+func insertJob(ctx context.Context, db querier, job *repo.JobDTO) error {
+	var once *string
+	if job.Once != nil {
+		t, err := time.Parse(time.RFC3339, *job.Once)
+		if err != nil {
+			return fmt.Errorf("once: %w", err)
+		}
+		s := t.UTC().Format(time.RFC3339)
+		once = &s
+	}
+	payloadBytes, err := json.Marshal(job.Payload)
+	if err != nil {
+		return err
+	}
+	retryPolicyBytes, err := marshalNullable(job.RetryPolicy)
+	if err != nil {
+		return err
+	}
+	var notificationsBytes []byte
+	if len(job.Notifications) > 0 {
+		if notificationsBytes, err = json.Marshal(job.Notifications); err != nil {
+			return err
+		}
+	}
+	labels := job.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labelsBytes, err := json.Marshal(labels)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, createQuery,
+		job.ID,
+		once,
+		job.Interval,
+		job.Cron,
+		job.Status,
+		job.CreatedAt,
+		job.LastFinishedAt,
+		job.NextRunAt,
+		string(payloadBytes),
+		job.Timezone,
+		textOrNil(retryPolicyBytes),
+		job.Timeout,
+		job.MisfirePolicy,
+		job.ConcurrencyPolicy,
+		job.WorkflowID,
+		job.Type,
+		textOrNil(notificationsBytes),
+		string(labelsBytes),
+	)
+	return err
+}
+
+func insertExecution(ctx context.Context, tx *sql.Tx, exec *repo.ExecutionDTO) error {
+	// NULL payload means the payload of the job
+	var payloadBytes []byte
+	if exec.Payload != nil {
+		var err error
+		if payloadBytes, err = json.Marshal(exec.Payload); err != nil {
+			return err
+		}
+	}
+	_, err := tx.ExecContext(ctx, createExecutionQuery,
+		exec.ID,
+		exec.JobID,
+		pointers.NilIfEmpty(exec.WorkerID),
+		exec.Status,
+		exec.Attempt,
+		exec.ScheduledAt,
+		pointers.NilIfZero(exec.StartedAt),
+		pointers.NilIfEmpty(exec.Reason),
+		exec.Trigger,
+		textOrNil(payloadBytes),
+		pointers.NilIfEmpty(exec.WorkflowRunID),
+	)
+	return err
+}
+
+func scanJob(row interface{ Scan(dest ...any) error }) (*repo.JobDTO, error) {
+	var job repo.JobDTO
+	var payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes []byte
+
+	if err := row.Scan(
+		&job.ID,
+		&job.Once,
+		&job.Interval,
+		&job.Cron,
+		&job.Status,
+		&job.CreatedAt,
+		&job.LastFinishedAt,
+		&job.NextRunAt,
+		&payloadBytes,
+		&job.Timezone,
+		&retryPolicyBytes,
+		&job.Timeout,
+		&job.MisfirePolicy,
+		&job.ConcurrencyPolicy,
+		&job.WorkflowID,
+		&job.Type,
+		&notificationsBytes,
+		&labelsBytes,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := fillJob(&job, payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// fillJob decodes the JSON columns of the job after scanning.
+func fillJob(job *repo.JobDTO, payloadBytes, retryPolicyBytes, notificationsBytes, labelsBytes []byte) error {
+	if err := unmarshalNullable(retryPolicyBytes, &job.RetryPolicy); err != nil {
+		return err
+	}
+	if err := unmarshalNullable(notificationsBytes, &job.Notifications); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(labelsBytes, &job.Labels); err != nil {
+		return err
+	}
+	// Задание без меток читается так же, как создавалось
+	if len(job.Labels) == 0 {
+		job.Labels = nil
+	}
+	return json.Unmarshal(payloadBytes, &job.Payload)
+}
+
+// unmarshalNullable decodes a nullable JSON column, v is left untouched for NULL.
+func unmarshalNullable(data []byte, v any) error {
+	if data == nil {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// marshalNullable encodes v for a nullable JSON column.
+func marshalNullable[T any](v *T) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// textOrNil passes JSON as TEXT, so that SQLite JSON functions accept it, and nil as NULL.
+func textOrNil(data []byte) any {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+func collectJobs(rows *sql.Rows) ([]repo.JobDTO, error) {
+	var jobs []repo.JobDTO
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *j)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// collectIDs reads the IDs returned by a query and closes its rows,
+// which frees the connection for the next statement of the transaction.
+func collectIDs(rows *sql.Rows, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,24 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"scheduler/internal/adapter/repo/repotest"
+	"scheduler/internal/adapter/repo/sqlite"
+	"scheduler/internal/cases"
+	sqlitemigrations "scheduler/pkg/migration/sqlite"
+	"testing"
+)
+
+func TestJobsRepo(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) cases.JobsRepo {
+		r, err := sqlite.NewJobsRepo(filepath.Join(t.TempDir(), "scheduler.db"))
+		if err != nil {
+			t.Fatalf("new repo: %v", err)
+		}
+		t.Cleanup(r.Close)
+		if err := sqlitemigrations.Migrate(r.DB()); err != nil {
+			t.Fatalf("migrate: %v", err)
+		}
+		return r
+	})
+}
@@ -0,0 +1,84 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"scheduler/internal/port/repo"
+)
+
+const (
+	// Куски добавляются только к выполнению, которое держит этот воркер, в порядке их передачи
+	appendExecutionLogsQuery = `
+		INSERT INTO execution_logs (execution_id, stream, data, created_at)
+		SELECT e.id, json_extract(c.value, '$.stream'), json_extract(c.value, '$.data'), ?3
+		FROM executions e, json_each(?4) c
+		WHERE e.id = ?1 AND e.worker_id = ?2 AND e.status = 'running'
+		ORDER BY c.key
+	`
+	listExecutionLogsQuery = `
+		SELECT seq, execution_id, stream, data, created_at
+		FROM execution_logs
+		WHERE execution_id = ?1 AND seq > ?2
+		ORDER BY seq
+		LIMIT ?3
+	`
+)
+
+// logChunk is a chunk of the output passed to SQLite as an element of a JSON array.
+type logChunk struct {
+	Stream string `json:"stream"`
+	Data   string `json:"data"`
+}
+
+// AppendExecutionLogs stores the chunks of the output of the execution held by the worker.
+// It returns repo.ErrConflict if the execution is not running or is held by another worker.
+func (r *JobsRepo) AppendExecutionLogs(ctx context.Context, execID string, workerID string, now int64, logs []repo.ExecutionLogDTO) error {
+	chunks := make([]logChunk, 0, len(logs))
+	for _, l := range logs {
+		chunks = append(chunks, logChunk{Stream: l.Stream, Data: l.Data})
+	}
+	chunksBytes, err := json.Marshal(chunks)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, appendExecutionLogsQuery, execID, workerID, now, string(chunksBytes))
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return conflict(ctx, tx, executionExistsQuery, execID)
+	}
+	return tx.Commit()
+}
+
+// ListExecutionLogs returns up to limit chunks of the output of the execution which follow the chunk after.
+func (r *JobsRepo) ListExecutionLogs(ctx context.Context, execID string, after int64, limit uint64) ([]repo.ExecutionLogDTO, error) {
+	rows, err := r.db.QueryContext(ctx, listExecutionLogsQuery, execID, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var logs []repo.ExecutionLogDTO
+	for rows.Next() {
+		var l repo.ExecutionLogDTO
+		if err := rows.Scan(&l.Seq, &l.ExecutionID, &l.Stream, &l.Data, &l.CreatedAt); err != nil {
+			return nil, err
+		}
+		logs = append(logs, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
@@ -0,0 +1,144 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"scheduler/internal/port/repo"
+	"scheduler/pkg/utils/pointers"
+)
+
+const (
+	createNotificationQuery = `
+		INSERT INTO notifications (id, job_id, execution_id, event, url, secret, body, status, attempts, created_at, next_attempt_at)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, 0, ?9, ?10)
+	`
+	listNotificationsQuery = `
+		SELECT id, job_id, execution_id, event, url, status, attempts, COALESCE(last_status_code, 0),
+			COALESCE(last_error, ''), created_at, COALESCE(next_attempt_at, 0), COALESCE(delivered_at, 0)
+		FROM notifications
+		WHERE job_id = ?1
+		ORDER BY created_at DESC
+	`
+	// Доставка откладывается на время захвата: если процесс упадет, не доставив, ее повторят после перезапуска.
+	// Запрос выполняется одной инструкцией, SQLite не даст двум захватам пересечься
+	claimNotificationsQuery = `
+		UPDATE notifications SET attempts = attempts + 1, next_attempt_at = ?2
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE status = 'pending' AND next_attempt_at <= ?1
+			ORDER BY next_attempt_at
+			LIMIT ?3
+		)
+		RETURNING id, job_id, execution_id, event, url, secret, body, status, attempts, created_at
+	`
+	finishNotificationQuery = `
+		UPDATE notifications SET status = ?2, last_status_code = ?3, last_error = ?4, next_attempt_at = ?5, delivered_at = ?6
+		WHERE id = ?1 AND status = 'pending'
+	`
+)
+
+// ListNotifications returns the deliveries of the notifications of the job, the latest first.
+func (r *JobsRepo) ListNotifications(ctx context.Context, jobID string) ([]repo.NotificationDTO, error) {
+	rows, err := r.db.QueryContext(ctx, listNotificationsQuery, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []repo.NotificationDTO
+	for rows.Next() {
+		var n repo.NotificationDTO
+		if err := rows.Scan(
+			&n.ID,
+			&n.JobID,
+			&n.ExecutionID,
+			&n.Event,
+			&n.URL,
+			&n.Status,
+			&n.Attempts,
+			&n.LastStatusCode,
+			&n.LastError,
+			&n.CreatedAt,
+			&n.NextAttemptAt,
+			&n.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// ClaimNotifications returns up to limit pending deliveries due by now, counting an attempt of each,
+// and hides them from other notifiers until claimUntil.
+func (r *JobsRepo) ClaimNotifications(ctx context.Context, now int64, claimUntil int64, limit uint64) ([]repo.NotificationDTO, error) {
+	rows, err := r.db.QueryContext(ctx, claimNotificationsQuery, now, claimUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []repo.NotificationDTO
+	for rows.Next() {
+		var n repo.NotificationDTO
+		if err := rows.Scan(
+			&n.ID,
+			&n.JobID,
+			&n.ExecutionID,
+			&n.Event,
+			&n.URL,
+			&n.Secret,
+			&n.Body,
+			&n.Status,
+			&n.Attempts,
+			&n.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		n.NextAttemptAt = claimUntil
+		notifications = append(notifications, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// FinishNotification stores the outcome of a delivery attempt.
+// A pending delivery is attempted again at NextAttemptAt.
+func (r *JobsRepo) FinishNotification(ctx context.Context, n *repo.NotificationDTO) error {
+	_, err := r.db.ExecContext(ctx, finishNotificationQuery,
+		n.ID,
+		n.Status,
+		pointers.NilIfZero(int64(n.LastStatusCode)),
+		pointers.NilIfEmpty(n.LastError),
+		pointers.NilIfZero(n.NextAttemptAt),
+		pointers.NilIfZero(n.DeliveredAt),
+	)
+	return err
+}
+
+func insertNotifications(ctx context.Context, tx *sql.Tx, notifications []repo.NotificationDTO) error {
+	for _, n := range notifications {
+		if _, err := tx.ExecContext(ctx, createNotificationQuery,
+			n.ID,
+			n.JobID,
+			n.ExecutionID,
+			n.Event,
+			n.URL,
+			n.Secret,
+			n.Body,
+			n.Status,
+			n.CreatedAt,
+			n.NextAttemptAt,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"scheduler/internal/port/repo"
+	"scheduler/pkg/utils/pointers"
+)
+
+const (
+	createWorkflowQuery = `
+		INSERT INTO workflows (id, name, created_at, nodes)
+		VALUES (?1, ?2, ?3, ?4)
+	`
+	readWorkflowQuery = `SELECT id, name, created_at, nodes FROM workflows WHERE id = ?1`
+
+	createWorkflowRunQuery = `
+		INSERT INTO workflow_runs (id, workflow_id, status, created_at)
+		VALUES (?1, ?2, ?3, ?4)
+	`
+	readWorkflowRunQuery = `
+		SELECT id, workflow_id, status, revision, created_at, COALESCE(finished_at, 0)
+		FROM workflow_runs
+		WHERE id = ?1
+	`
+	listActiveWorkflowRunsQuery = `
+		SELECT id, workflow_id, status, revision, created_at, COALESCE(finished_at, 0)
+		FROM workflow_runs
+		WHERE status = 'running'
+			AND (?2 IS NULL OR (hashtext(id) & 2147483647) % ?2 IN (SELECT value FROM json_each(?3)))
+		ORDER BY created_at, id
+		LIMIT ?1
+	`
+	listWorkflowRunExecutionsQuery = `
+		SELECT id, job_id, COALESCE(worker_id, ''), status, attempt, trigger, COALESCE(scheduled_at, 0),
+			COALESCE(started_at, 0), COALESCE(finished_at, 0), COALESCE(output, ''), COALESCE(reason, ''), workflow_run_id,
+			COALESCE(error, '')
+		FROM executions
+		WHERE workflow_run_id = ?1
+		ORDER BY scheduled_at, attempt, id
+	`
+	// Ревизия защищает от двух одновременных продвижений одного запуска, запускающих узлы дважды
+	advanceWorkflowRunQuery = `
+		UPDATE workflow_runs SET status = ?2, finished_at = ?3, revision = revision + 1
+		WHERE id = ?1 AND status = 'running' AND revision = ?4
+	`
+	// Приостановленные задания узлов остаются приостановленными, их выполнения ждут возобновления
+	startNodeJobsQuery = `
+		UPDATE jobs SET status = 'running'
+		WHERE id IN (SELECT value FROM json_each(?1)) AND status <> 'paused'
+	`
+)
+
+// CreateWorkflow stores the workflow together with the jobs of its nodes.
+func (r *JobsRepo) CreateWorkflow(ctx context.Context, workflow *repo.WorkflowDTO, jobs []repo.JobDTO) error {
+	nodesBytes, err := json.Marshal(workflow.Nodes)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, createWorkflowQuery, workflow.ID, workflow.Name, workflow.CreatedAt, string(nodesBytes)); err != nil {
+		return err
+	}
+	for i := range jobs {
+		if err := insertJob(ctx, tx, &jobs[i]); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ReadWorkflow returns the workflow by its ID.
+func (r *JobsRepo) ReadWorkflow(ctx context.Context, workflowID string) (*repo.WorkflowDTO, error) {
+	var w repo.WorkflowDTO
+	var nodesBytes []byte
+	err := r.db.QueryRowContext(ctx, readWorkflowQuery, workflowID).Scan(&w.ID, &w.Name, &w.CreatedAt, &nodesBytes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(nodesBytes, &w.Nodes); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// StartWorkflowRun stores the run and queues the executions of its first nodes.
+func (r *JobsRepo) StartWorkflowRun(ctx context.Context, run *repo.WorkflowRunDTO, execs []repo.ExecutionDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, createWorkflowRunQuery, run.ID, run.WorkflowID, run.Status, run.CreatedAt); err != nil {
+		return err
+	}
+	if err := startNodes(ctx, tx, execs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ReadWorkflowRun returns the workflow run by its ID.
+func (r *JobsRepo) ReadWorkflowRun(ctx context.Context, runID string) (*repo.WorkflowRunDTO, error) {
+	run, err := scanWorkflowRun(r.db.QueryRowContext(ctx, readWorkflowRunQuery, runID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// ListActiveWorkflowRuns returns running workflow runs, the oldest first.
+// Non-nil shards restricts the runs to the owned shards.
+func (r *JobsRepo) ListActiveWorkflowRuns(ctx context.Context, shards *repo.ShardsDTO, limit uint64) ([]repo.WorkflowRunDTO, error) {
+	count, owned := shardArgs(shards)
+	rows, err := r.db.QueryContext(ctx, listActiveWorkflowRunsQuery, limit, count, owned)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []repo.WorkflowRunDTO
+	for rows.Next() {
+		run, err := scanWorkflowRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, *run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// ListWorkflowRunExecutions returns the executions of the nodes of the run in the order they were scheduled.
+func (r *JobsRepo) ListWorkflowRunExecutions(ctx context.Context, runID string) ([]repo.ExecutionDTO, error) {
+	rows, err := r.db.QueryContext(ctx, listWorkflowRunExecutionsQuery, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var execs []repo.ExecutionDTO
+	for rows.Next() {
+		var e repo.ExecutionDTO
+		if err := rows.Scan(
+			&e.ID,
+			&e.JobID,
+			&e.WorkerID,
+			&e.Status,
+			&e.Attempt,
+			&e.Trigger,
+			&e.ScheduledAt,
+			&e.StartedAt,
+			&e.FinishedAt,
+			&e.Output,
+			&e.Reason,
+			&e.WorkflowRunID,
+			&e.Error,
+		); err != nil {
+			return nil, err
+		}
+		execs = append(execs, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return execs, nil
+}
+
+// AdvanceWorkflowRun stores the new status of the run and queues the executions of the nodes ready to start.
+// It returns repo.ErrConflict if the run has finished or been advanced since it was read.
+func (r *JobsRepo) AdvanceWorkflowRun(ctx context.Context, run *repo.WorkflowRunDTO, execs []repo.ExecutionDTO) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, advanceWorkflowRunQuery, run.ID, run.Status, pointers.NilIfZero(run.FinishedAt), run.Revision)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return repo.ErrConflict
+	}
+	if err := startNodes(ctx, tx, execs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// startNodes queues the executions of workflow nodes and marks their jobs as running.
+func startNodes(ctx context.Context, tx *sql.Tx, execs []repo.ExecutionDTO) error {
+	if len(execs) == 0 {
+		return nil
+	}
+	jobIDs := make([]string, 0, len(execs))
+	for i := range execs {
+		if err := insertExecution(ctx, tx, &execs[i]); err != nil {
+			return err
+		}
+		jobIDs = append(jobIDs, execs[i].JobID)
+	}
+	jobIDsBytes, err := json.Marshal(jobIDs)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, startNodeJobsQuery, string(jobIDsBytes))
+	return err
+}
+
+func scanWorkflowRun(row interface{ Scan(dest ...any) error }) (*repo.WorkflowRunDTO, error) {
+	var run repo.WorkflowRunDTO
+	if err := row.Scan(&run.ID, &run.WorkflowID, &run.Status, &run.Revision, &run.CreatedAt, &run.FinishedAt); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
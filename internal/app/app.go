@@ -1,25 +1,90 @@
 package app
 
 import (
+	"context"
 	"github.com/go-chi/chi/v5"
 	middleware "github.com/oapi-codegen/nethttp-middleware"
 	"go.uber.org/zap"
+	"os"
 	"scheduler/config"
+	"scheduler/internal/adapter/executor/cmdexec"
+	"scheduler/internal/adapter/executor/httpexec"
+	"scheduler/internal/adapter/notifier/webhook"
+	"scheduler/internal/adapter/repo/memory"
 	"scheduler/internal/adapter/repo/postgres"
+	"scheduler/internal/adapter/repo/sqlite"
 	"scheduler/internal/cases"
+	"scheduler/internal/entity"
 	"scheduler/internal/input/http/gen"
 	"scheduler/internal/input/http/handler"
 )
 
 func Start(cfg *config.Config, logger *zap.Logger) error {
 
-	jobsRepo, err := postgres.NewJobsRepo(cfg.PgConnStr)
+	var jobsRepo cases.JobsRepo
+	var pgRepo *postgres.JobsRepo
+	switch cfg.StorageBackend {
+	case config.StorageMemory:
+		jobsRepo = memory.NewJobsRepo()
+		logger.Warn("in-memory storage: data is lost on restart and is not shared with other replicas")
+	case config.StorageSQLite:
+		sqliteRepo, err := sqlite.NewJobsRepo(cfg.SQLitePath)
+		if err != nil {
+			logger.Fatal("failed to open SQLite database:", zap.Error(err))
+			return err
+		}
+		jobsRepo = sqliteRepo
+	default:
+		var err error
+		pgRepo, err = postgres.NewJobsRepo(cfg.PgConnStr)
+		if err != nil {
+			logger.Fatal("failed to connect to DB:", zap.Error(err))
+			return err
+		}
+		jobsRepo = pgRepo
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	workerID, err := os.Hostname()
 	if err != nil {
-		logger.Fatal("failed to connect to DB:", zap.Error(err))
-		return err
+		workerID = "scheduler"
+	}
+	// Задания типа worker выполняют внешние воркеры, остальные — движок в своем процессе
+	executors := map[entity.JobType]cases.Executor{
+		entity.JobTypeHTTP: httpexec.NewExecutor(nil, cfg.HTTPMaxBody, cfg.HTTPTimeout),
 	}
+	if len(cfg.ExecCommands) > 0 {
+		executors[entity.JobTypeExec] = cmdexec.NewExecutor(cfg.ExecCommands, cfg.ExecTimeout, cfg.ExecMaxOutput)
+		logger.Warn("exec jobs enabled", zap.Strings("commands", cfg.ExecCommands))
+	}
+
+	// API обслуживают все реплики, задания запускает лидер или владелец шарда задания
+	switch {
+	case pgRepo == nil:
+		// Хранилища в памяти и SQLite принадлежат одному процессу: выборы лидера и шарды не нужны
+		if cfg.DispatchMode == config.DispatchSharded {
+			logger.Warn("sharded dispatch ignored without postgres storage", zap.String("storage", cfg.StorageBackend))
+		}
+		engine := cases.NewEngine(jobsRepo, executors, cfg.ExecutorWorkers, nil, logger, cfg.EngineTick, cfg.LeaseTTL, workerID)
+		go engine.Run(ctx)
+	case cfg.DispatchMode == config.DispatchSharded:
+		shards := postgres.NewShardCoordinator(pgRepo, logger, workerID, cfg.ShardCount, cfg.ShardRebalanceInterval)
+		engine := cases.NewEngine(jobsRepo, executors, cfg.ExecutorWorkers, shards, logger, cfg.EngineTick, cfg.LeaseTTL, workerID)
+		go shards.Run(ctx)
+		go engine.Run(ctx)
+	default:
+		engine := cases.NewEngine(jobsRepo, executors, cfg.ExecutorWorkers, nil, logger, cfg.EngineTick, cfg.LeaseTTL, workerID)
+		elector := postgres.NewLeaderElector(pgRepo, logger, cfg.LeaderCheckInterval)
+		go elector.Run(ctx, engine.Run)
+	}
+
+	// Уведомления доставляют все реплики, доставка захватывается на время вызова вебхука
+	notifier := cases.NewNotifier(jobsRepo, webhook.NewSender(nil), logger, cfg.NotifierInterval)
+	go notifier.Run(ctx)
 
-	schedulerCase := cases.NewSchedulerCase(jobsRepo)
+	schedulerCase := cases.NewSchedulerCase(jobsRepo, cfg.LeaseTTL, cfg.ExecCommands)
 	schedulerHandler := handler.NewHandler(schedulerCase)
 
 	r := chi.NewRouter()
@@ -0,0 +1,85 @@
+package cases
+
+import (
+	"context"
+	"fmt"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReasonRequeued marks executions queued by requeueing a dead letter.
+const ReasonRequeued = "requeued"
+
+// ListDeadLetters returns runs which failed after exhausting their retry policy, the most recent first.
+func (r *SchedulerCase) ListDeadLetters(ctx context.Context) ([]entity.DeadLetter, error) {
+	dtos, err := r.jobsRepo.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list dead letters error:%w", err)
+	}
+
+	deadLetters := make([]entity.DeadLetter, 0, len(dtos))
+	for _, d := range dtos {
+		deadLetters = append(deadLetters, deadLetterDTOToEntity(&d))
+	}
+	return deadLetters, nil
+}
+
+// RequeueDeadLetter starts a new run of the dead-lettered job with a fresh retry budget
+// and returns the ID of its execution.
+func (r *SchedulerCase) RequeueDeadLetter(ctx context.Context, deadLetterID string) (string, error) {
+	if deadLetterID == "" {
+		return "", ErrInvalidJob
+	}
+
+	exec := &repo.ExecutionDTO{
+		ID:          uuid.NewString(),
+		Status:      string(repo.Queued),
+		Attempt:     1,
+		Trigger:     entity.TriggerManual,
+		ScheduledAt: time.Now().UnixMilli(),
+		Reason:      ReasonRequeued,
+	}
+	if err := r.jobsRepo.RequeueDeadLetter(ctx, deadLetterID, exec); err != nil {
+		return "", mapExecutionError("requeue dead letter", err)
+	}
+	return exec.ID, nil
+}
+
+// DeleteDeadLetter discards the dead letter.
+func (r *SchedulerCase) DeleteDeadLetter(ctx context.Context, deadLetterID string) error {
+	if deadLetterID == "" {
+		return ErrInvalidJob
+	}
+	if err := r.jobsRepo.DeleteDeadLetter(ctx, deadLetterID); err != nil {
+		return mapExecutionError("delete dead letter", err)
+	}
+	return nil
+}
+
+// deadLetterFor returns the dead letter recording the last failed execution of a run.
+func deadLetterFor(failed *repo.ExecutionDTO, now time.Time) *repo.DeadLetterDTO {
+	return &repo.DeadLetterDTO{
+		ID:          uuid.NewString(),
+		JobID:       failed.JobID,
+		ExecutionID: failed.ID,
+		Attempts:    failed.Attempt,
+		Reason:      failed.Reason,
+		Output:      failed.Output,
+		CreatedAt:   now.UnixMilli(),
+	}
+}
+
+func deadLetterDTOToEntity(d *repo.DeadLetterDTO) entity.DeadLetter {
+	return entity.DeadLetter{
+		Id:          d.ID,
+		JobId:       d.JobID,
+		ExecutionId: d.ExecutionID,
+		Attempts:    d.Attempts,
+		Reason:      d.Reason,
+		Output:      d.Output,
+		CreatedAt:   d.CreatedAt,
+	}
+}
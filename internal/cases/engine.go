@@ -0,0 +1,455 @@
+package cases
+
+import (
+	"context"
+	"errors"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTick            = time.Second
+	defaultExecutorWorkers = 10
+	dueJobsBatchSize       = 100
+	expiredLeasesBatchSize = 100
+
+	// defaultMisfireThreshold is how late a job may be picked up before its fire time counts as missed.
+	// The threshold is at least two ticks.
+	defaultMisfireThreshold = time.Minute
+
+	// ReasonMisfire marks fire times skipped by the misfire policy of the job.
+	ReasonMisfire = "misfire"
+	// ReasonConcurrencyForbid marks fire times skipped because the previous run was still in progress.
+	ReasonConcurrencyForbid = "concurrency_forbid"
+	// ReasonReplaced marks executions cancelled in favour of a newer run of the job.
+	ReasonReplaced = "replaced"
+)
+
+// Executor runs a single job in-process. The output is stored on the execution
+// whether the job succeeds or not.
+type Executor interface {
+	Execute(ctx context.Context, job entity.Job) (output string, err error)
+}
+
+// Shards tells which part of the jobs an engine dispatches
+// when the work is partitioned between scheduler replicas.
+type Shards interface {
+	// Owned returns the shards currently held by this replica, possibly none of them.
+	Owned() repo.ShardsDTO
+}
+
+// Engine polls the repository on every tick and fires due jobs.
+// Fired jobs are queued: the engine leases and runs in-process the jobs of the types
+// it has executors for, at most workers of them at a time, the rest is left to external workers.
+// Without shards the engine dispatches all jobs, with them only the jobs of the owned shards.
+type Engine struct {
+	jobsRepo  JobsRepo
+	executors map[entity.JobType]Executor
+	types     []string // job types with executors
+	slots     chan struct{}
+	shards    Shards
+	logger    *zap.Logger
+	tick      time.Duration
+	leaseTTL  time.Duration
+	workerID  string
+
+	misfireThreshold time.Duration
+
+	mu   sync.Mutex
+	runs map[string]context.CancelFunc // in-process executions by ID
+
+	wg sync.WaitGroup
+}
+
+func NewEngine(jobsRepo JobsRepo, executors map[entity.JobType]Executor, workers int, shards Shards, logger *zap.Logger, tick time.Duration, leaseTTL time.Duration, workerID string) *Engine {
+	if tick <= 0 {
+		tick = defaultTick
+	}
+	if leaseTTL <= 0 {
+		leaseTTL = defaultLeaseTTL
+	}
+	if workers <= 0 {
+		workers = defaultExecutorWorkers
+	}
+	types := make([]string, 0, len(executors))
+	for t := range executors {
+		types = append(types, string(t))
+	}
+	return &Engine{
+		jobsRepo:         jobsRepo,
+		executors:        executors,
+		types:            types,
+		slots:            make(chan struct{}, workers),
+		shards:           shards,
+		logger:           logger,
+		tick:             tick,
+		leaseTTL:         leaseTTL,
+		workerID:         workerID,
+		misfireThreshold: max(defaultMisfireThreshold, 2*tick),
+		runs:             make(map[string]context.CancelFunc),
+	}
+}
+
+// Run fires due jobs until ctx is cancelled and then waits for running executions.
+func (e *Engine) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.tick)
+	defer ticker.Stop()
+
+	for {
+		e.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			e.wg.Wait()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Engine) poll(ctx context.Context) {
+	now := time.Now()
+	if len(e.executors) > 0 {
+		defer e.runQueued(ctx, now)
+	}
+
+	var shards *repo.ShardsDTO
+	if e.shards != nil {
+		owned := e.shards.Owned()
+		if len(owned.Owned) == 0 {
+			return
+		}
+		shards = &owned
+	}
+
+	e.reap(ctx, shards, now)
+	e.timeOut(ctx, shards, now)
+	e.advanceWorkflows(ctx, shards, now)
+
+	jobs, err := e.jobsRepo.ListDue(ctx, now.UnixMilli(), shards, dueJobsBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Error("list due jobs", zap.Error(err))
+		}
+		return
+	}
+
+	for i := range jobs {
+		e.fire(ctx, dtoToEntity(&jobs[i]), now)
+	}
+}
+
+func (e *Engine) fire(ctx context.Context, job entity.Job, now time.Time) {
+	schedule, err := job.Schedule()
+	if err != nil {
+		e.logger.Error("invalid job schedule", zap.String("job_id", job.ID), zap.Error(err))
+		return
+	}
+
+	// Fire times missed during a downtime, a pause or a long previous run are handled by the misfire policy
+	due := time.UnixMilli(job.NextRunAt)
+	plan := job.MisfirePolicy.Plan(schedule, due, now, now.Sub(due) > e.misfireThreshold)
+	skipped := skippedExecutions(job.ID, plan.Skipped, ReasonMisfire, now)
+	if len(skipped) > 0 {
+		e.logger.Warn("fire times missed",
+			zap.String("job_id", job.ID),
+			zap.String("misfire_policy", string(job.MisfirePolicy)),
+			zap.Int("skipped", len(skipped)),
+		)
+	}
+
+	running := job.Status == entity.Running
+	if plan.Fire && running && job.ConcurrencyPolicy == entity.ConcurrencyForbid {
+		e.logger.Info("job still running, run skipped", zap.String("job_id", job.ID))
+		plan.Fire = false
+		skipped = append(skipped, skippedExecutions(job.ID, []time.Time{now}, ReasonConcurrencyForbid, now)...)
+	}
+
+	if !plan.Fire {
+		// ErrConflict means the job has been fired or paused meanwhile
+		err := e.jobsRepo.SkipFires(ctx, job.ID, job.NextRunAt, unixMilliOrNil(plan.Next), skipped)
+		if err != nil && !errors.Is(err, repo.ErrConflict) {
+			e.logger.Error("skip fires", zap.String("job_id", job.ID), zap.Error(err))
+		}
+		return
+	}
+
+	// The run is leased by a worker or by the engine itself, see runQueued
+	exec := &repo.ExecutionDTO{
+		ID:          uuid.NewString(),
+		JobID:       job.ID,
+		Status:      string(repo.Queued),
+		Attempt:     1,
+		Trigger:     entity.TriggerSchedule,
+		ScheduledAt: now.UnixMilli(),
+	}
+
+	replaced, err := e.jobsRepo.StartExecution(ctx, exec, &job.NextRunAt, unixMilliOrNil(plan.Next), skipped)
+	if err != nil {
+		if !errors.Is(err, repo.ErrConflict) {
+			e.logger.Error("start execution", zap.String("job_id", job.ID), zap.Error(err))
+		}
+		return
+	}
+	if len(replaced) > 0 {
+		e.logger.Info("running executions replaced", zap.String("job_id", job.ID), zap.Strings("execution_ids", replaced))
+		e.cancelRuns(replaced)
+	}
+}
+
+// runQueued leases due queued executions of the jobs the engine has executors for
+// and runs them in-process, as many as there are free worker slots.
+func (e *Engine) runQueued(ctx context.Context, now time.Time) {
+	// Slots are taken only here, so the free ones can't run out meanwhile
+	free := cap(e.slots) - len(e.slots)
+	if free == 0 {
+		return
+	}
+	leases, err := e.jobsRepo.LeaseExecutions(ctx, e.workerID, e.types, now.UnixMilli(), now.Add(e.leaseTTL).UnixMilli(), uint64(min(free, dueJobsBatchSize)))
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Error("lease executions", zap.Error(err))
+		}
+		return
+	}
+
+	for i := range leases {
+		job := dtoToEntity(&leases[i].Job)
+		exec := &leases[i].Execution
+		e.slots <- struct{}{}
+		e.wg.Add(1)
+		go func() {
+			defer e.wg.Done()
+			defer func() { <-e.slots }()
+			e.execute(ctx, job, exec)
+		}()
+	}
+}
+
+func (e *Engine) execute(ctx context.Context, job entity.Job, exec *repo.ExecutionDTO) {
+	// The run is cancelled when another run replaces it
+	runCtx, cancelRun := context.WithCancel(ctx)
+	defer cancelRun()
+	e.trackRun(exec.ID, cancelRun)
+	defer e.untrackRun(exec.ID)
+
+	// A leased execution is reaped unless its lease is extended while it runs
+	stopHeartbeat := func() {}
+	if exec.LeaseExpiresAt != 0 {
+		stopHeartbeat = e.keepLease(ctx, exec.ID, cancelRun)
+	}
+
+	if timeout, err := entity.ParseTimeout(job.Timeout); err == nil {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(runCtx, timeout)
+		defer cancel()
+	}
+
+	output, err := e.executors[job.Type].Execute(runCtx, job)
+	stopHeartbeat()
+
+	now := time.Now()
+	exec.Status = string(repo.Completed)
+	exec.FinishedAt = now.UnixMilli()
+	exec.Output = output
+	if err != nil {
+		exec.Error = err.Error()
+	}
+
+	var retry *repo.ExecutionDTO
+	var deadLetter *repo.DeadLetterDTO
+	switch {
+	case err != nil && ctx.Err() == nil && errors.Is(runCtx.Err(), context.DeadlineExceeded):
+		e.logger.Warn("job timed out", zap.String("job_id", job.ID), zap.Int("attempt", exec.Attempt))
+		retry, deadLetter = failExecution(job.RetryPolicy, exec, repo.TimedOut, ReasonTimeout, now)
+	case err != nil && ctx.Err() == nil && errors.Is(runCtx.Err(), context.Canceled):
+		// The execution has already been finished by whoever cancelled it
+		e.logger.Info("job cancelled", zap.String("job_id", job.ID), zap.String("execution_id", exec.ID))
+		return
+	case err != nil && ctx.Err() != nil:
+		// The engine stopped or lost the leadership, which says nothing about the job itself.
+		// The execution stays running until its lease expires and the reaper queues it again,
+		// a lost lease does not count as an attempt.
+		e.logger.Info("job interrupted", zap.String("job_id", job.ID), zap.String("execution_id", exec.ID))
+		return
+	case err != nil:
+		e.logger.Warn("job failed", zap.String("job_id", job.ID), zap.Int("attempt", exec.Attempt), zap.Error(err))
+		retry, deadLetter = failExecution(job.RetryPolicy, exec, repo.Failed, errorClass(err), now)
+	}
+
+	// The result is stored even if the engine is stopping.
+	// ErrConflict means the execution has been timed out meanwhile.
+	notifications := notificationsFor(job.Notifications, exec, deadLetter, now)
+	if err := e.jobsRepo.FinishExecution(context.WithoutCancel(ctx), exec, retry, deadLetter, notifications); err != nil && !errors.Is(err, repo.ErrConflict) {
+		e.logger.Error("finish execution", zap.String("job_id", job.ID), zap.Error(err))
+	}
+}
+
+// keepLease extends the lease of the execution until the returned function is called.
+// cancel is called when the execution has been timed out or replaced meanwhile.
+func (e *Engine) keepLease(ctx context.Context, execID string, cancel context.CancelFunc) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(e.leaseTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			now := time.Now()
+			stopRun, err := e.jobsRepo.Heartbeat(ctx, execID, e.workerID, now.UnixMilli(), now.Add(e.leaseTTL).UnixMilli())
+			if err != nil {
+				e.logger.Error("extend lease", zap.String("execution_id", execID), zap.Error(err))
+			}
+			if stopRun {
+				cancel()
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// reap fails executions whose workers stopped sending heartbeats and queues the jobs again.
+func (e *Engine) reap(ctx context.Context, shards *repo.ShardsDTO, now time.Time) {
+	expired, err := e.jobsRepo.ListExpiredLeases(ctx, now.UnixMilli(), shards, expiredLeasesBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Error("list expired leases", zap.Error(err))
+		}
+		return
+	}
+
+	for i := range expired {
+		exec := &expired[i]
+		exec.Status = string(repo.Failed)
+		exec.Reason = ReasonLeaseExpired
+		exec.FinishedAt = now.UnixMilli()
+
+		next := &repo.ExecutionDTO{
+			ID:            uuid.NewString(),
+			JobID:         exec.JobID,
+			Status:        string(repo.Queued),
+			Attempt:       exec.Attempt, // a lost lease does not count as an attempt
+			Trigger:       exec.Trigger,
+			Payload:       exec.Payload,
+			WorkflowRunID: exec.WorkflowRunID,
+			ScheduledAt:   now.UnixMilli(),
+			Reason:        ReasonLeaseExpired,
+		}
+
+		// ErrConflict means the worker managed to send a heartbeat or to complete the execution
+		if err := e.jobsRepo.RequeueExecution(ctx, exec, next); err != nil {
+			if !errors.Is(err, repo.ErrConflict) {
+				e.logger.Error("requeue execution", zap.String("execution_id", exec.ID), zap.Error(err))
+			}
+			continue
+		}
+		e.logger.Warn("lease expired, job queued again",
+			zap.String("job_id", exec.JobID),
+			zap.String("worker_id", exec.WorkerID),
+			zap.String("execution_id", exec.ID),
+		)
+	}
+}
+
+// timeOut fails executions which exceeded the timeout of their jobs.
+// External workers learn about it from the heartbeat response.
+func (e *Engine) timeOut(ctx context.Context, shards *repo.ShardsDTO, now time.Time) {
+	execs, err := e.jobsRepo.ListTimedOut(ctx, now.UnixMilli(), shards, expiredLeasesBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Error("list timed out executions", zap.Error(err))
+		}
+		return
+	}
+
+	for i := range execs {
+		exec := &execs[i]
+		jobDTO, err := e.jobsRepo.Read(ctx, exec.JobID)
+		if err != nil {
+			e.logger.Error("read job", zap.String("job_id", exec.JobID), zap.Error(err))
+			continue
+		}
+
+		retry, deadLetter := failExecution(retryPolicyToEntity(jobDTO.RetryPolicy), exec, repo.TimedOut, ReasonTimeout, now)
+		notifications := notificationsFor(notificationsToEntity(jobDTO.Notifications), exec, deadLetter, now)
+
+		// ErrConflict means the execution finished meanwhile
+		if err := e.jobsRepo.FinishExecution(ctx, exec, retry, deadLetter, notifications); err != nil {
+			if !errors.Is(err, repo.ErrConflict) {
+				e.logger.Error("time out execution", zap.String("execution_id", exec.ID), zap.Error(err))
+			}
+			continue
+		}
+		e.logger.Warn("execution timed out",
+			zap.String("job_id", exec.JobID),
+			zap.String("worker_id", exec.WorkerID),
+			zap.String("execution_id", exec.ID),
+		)
+	}
+}
+
+// trackRun remembers how to cancel the execution running in-process.
+func (e *Engine) trackRun(execID string, cancel context.CancelFunc) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.runs[execID] = cancel
+}
+
+func (e *Engine) untrackRun(execID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.runs, execID)
+}
+
+// cancelRuns cancels those of the executions which run in-process.
+func (e *Engine) cancelRuns(execIDs []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, id := range execIDs {
+		if cancel, ok := e.runs[id]; ok {
+			cancel()
+		}
+	}
+}
+
+// skippedExecutions records the fire times dropped for the given reason.
+func skippedExecutions(jobID string, fireTimes []time.Time, reason string, now time.Time) []repo.ExecutionDTO {
+	execs := make([]repo.ExecutionDTO, 0, len(fireTimes))
+	for _, t := range fireTimes {
+		execs = append(execs, repo.ExecutionDTO{
+			ID:          uuid.NewString(),
+			JobID:       jobID,
+			Status:      string(repo.Skipped),
+			Attempt:     1,
+			Trigger:     entity.TriggerSchedule,
+			ScheduledAt: t.UnixMilli(),
+			FinishedAt:  now.UnixMilli(),
+			Reason:      reason,
+		})
+	}
+	return execs
+}
+
+// unixMilliOrNil returns t in Unix milliseconds, or nil for the zero time.
+func unixMilliOrNil(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	ms := t.UnixMilli()
+	return &ms
+}
@@ -0,0 +1,206 @@
+package cases_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"scheduler/internal/adapter/executor/cmdexec"
+	"scheduler/internal/adapter/repo/memory"
+	"scheduler/internal/cases"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	testTick     = 10 * time.Millisecond
+	testLeaseTTL = 150 * time.Millisecond
+)
+
+// blockingExecutor runs until release is closed or the run is cancelled.
+type blockingExecutor struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func newBlockingExecutor() *blockingExecutor {
+	return &blockingExecutor{started: make(chan struct{}, 100), release: make(chan struct{})}
+}
+
+func (x *blockingExecutor) Execute(ctx context.Context, job entity.Job) (string, error) {
+	x.started <- struct{}{}
+	select {
+	case <-x.release:
+		return "done", nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func TestEngineStopLeavesRunToReaper(t *testing.T) {
+	r := memory.NewJobsRepo()
+	job := newTestJob("1h", entity.ConcurrencyAllow)
+	createJob(t, r, job)
+
+	x := newBlockingExecutor()
+	ctx, stop := context.WithCancel(context.Background())
+	done := runEngine(ctx, r, map[entity.JobType]cases.Executor{entity.JobTypeHTTP: x})
+	select {
+	case <-x.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("job did not start")
+	}
+	// Остановка движка, как и потеря лидерства, прерывает выполнение
+	stop()
+	<-done
+
+	execs := listExecutions(t, r, job.ID)
+	if len(execs) != 1 || execs[0].Status != string(repo.Running) {
+		t.Fatalf("executions after stop = %+v, want one running", execs)
+	}
+	if letters, _ := r.ListDeadLetters(context.Background()); len(letters) != 0 {
+		t.Errorf("dead letters after stop = %+v, want none", letters)
+	}
+
+	// Истекшую аренду подбирает движок без исполнителей и ставит задание в очередь, не считая попытку
+	time.Sleep(testLeaseTTL)
+	ctx, stop = context.WithCancel(context.Background())
+	done = runEngine(ctx, r, nil)
+	time.Sleep(5 * testTick)
+	stop()
+	<-done
+
+	var failed, queued int
+	for _, e := range listExecutions(t, r, job.ID) {
+		switch {
+		case e.Status == string(repo.Failed) && e.Reason == cases.ReasonLeaseExpired:
+			failed++
+		case e.Status == string(repo.Queued) && e.Attempt == 1:
+			queued++
+		}
+	}
+	if failed != 1 || queued != 1 {
+		t.Errorf("executions after reaping = %+v, want the interrupted one requeued", listExecutions(t, r, job.ID))
+	}
+}
+
+func TestEngineForbidSkipsOverlappingRuns(t *testing.T) {
+	r := memory.NewJobsRepo()
+	job := newTestJob("30ms", entity.ConcurrencyForbid)
+	createJob(t, r, job)
+
+	x := newBlockingExecutor()
+	ctx, stop := context.WithCancel(context.Background())
+	done := runEngine(ctx, r, map[entity.JobType]cases.Executor{entity.JobTypeHTTP: x})
+
+	// Выполнение длится дольше нескольких интервалов, а новые запуски не появляются
+	var skipped int
+	for deadline := time.Now().Add(300 * time.Millisecond); time.Now().Before(deadline); time.Sleep(5 * time.Millisecond) {
+		var active int
+		skipped = 0
+		for _, e := range listExecutions(t, r, job.ID) {
+			switch {
+			case e.Status == string(repo.Queued) || e.Status == string(repo.Running):
+				active++
+			case e.Status == string(repo.Skipped) && e.Reason == cases.ReasonConcurrencyForbid:
+				skipped++
+			}
+		}
+		if active > 1 {
+			t.Fatalf("%d active executions of a forbid job", active)
+		}
+	}
+	started := len(x.started)
+	close(x.release)
+	stop()
+	<-done
+
+	if started != 1 {
+		t.Errorf("%d runs started while the first one was running, want 1", started)
+	}
+	if skipped == 0 {
+		t.Errorf("no fire times skipped with reason %s", cases.ReasonConcurrencyForbid)
+	}
+}
+
+func TestExecutorRechecksAllowlist(t *testing.T) {
+	ctx := context.Background()
+	r := memory.NewJobsRepo()
+	sc := cases.NewSchedulerCase(r, 0, []string{"/bin/echo", "/usr/bin/touch"})
+	jobID, err := sc.Create(ctx, &entity.Job{Type: entity.JobTypeExec, Interval: "1h", Payload: map[string]any{"command": "/bin/echo"}})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	// Команду запуска вручную убрали из списка разрешенных, пока выполнение ждало в очереди
+	marker := filepath.Join(t.TempDir(), "pwned")
+	execID, err := sc.Trigger(ctx, jobID, map[string]any{"command": "/usr/bin/touch", "args": []any{marker}})
+	if err != nil {
+		t.Fatalf("trigger: %v", err)
+	}
+
+	runCtx, stop := context.WithCancel(ctx)
+	done := runEngine(runCtx, r, map[entity.JobType]cases.Executor{entity.JobTypeExec: cmdexec.NewExecutor([]string{"/bin/echo"}, 0, 0)})
+	var exec *repo.ExecutionDTO
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(testTick) {
+		if exec, err = r.ReadExecution(ctx, execID); err == nil && exec.FinishedAt != 0 {
+			break
+		}
+	}
+	stop()
+	<-done
+
+	if exec == nil || exec.Status != string(repo.Failed) {
+		t.Errorf("execution of a command no longer allowed = %+v, want failed", exec)
+	}
+	if _, err := os.Stat(marker); !os.IsNotExist(err) {
+		t.Errorf("the command no longer allowed ran: %v", err)
+	}
+}
+
+// runEngine runs an engine over r until ctx is cancelled, done is closed once it returns.
+func runEngine(ctx context.Context, r cases.JobsRepo, executors map[entity.JobType]cases.Executor) (done chan struct{}) {
+	engine := cases.NewEngine(r, executors, 0, nil, zap.NewNop(), testTick, testLeaseTTL, uuid.NewString())
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		engine.Run(ctx)
+	}()
+	return done
+}
+
+func newTestJob(interval string, concurrency entity.ConcurrencyPolicy) *repo.JobDTO {
+	now := time.Now().UnixMilli()
+	return &repo.JobDTO{
+		ID:                uuid.NewString(),
+		Type:              string(entity.JobTypeHTTP),
+		Interval:          &interval,
+		Status:            repo.Queued,
+		CreatedAt:         now,
+		NextRunAt:         &now,
+		Payload:           map[string]any{},
+		Timezone:          "UTC",
+		MisfirePolicy:     string(entity.MisfireFireOnceNow),
+		ConcurrencyPolicy: string(concurrency),
+	}
+}
+
+func createJob(t *testing.T, r cases.JobsRepo, job *repo.JobDTO) {
+	t.Helper()
+	if err := r.Create(context.Background(), job); err != nil {
+		t.Fatalf("create job: %v", err)
+	}
+}
+
+func listExecutions(t *testing.T, r cases.JobsRepo, jobID string) []repo.ExecutionDTO {
+	t.Helper()
+	execs, err := r.ListExecutions(context.Background(), jobID, &repo.ExecutionsQueryDTO{Limit: 1000})
+	if err != nil {
+		t.Fatalf("list executions: %v", err)
+	}
+	return execs
+}
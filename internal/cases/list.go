@@ -0,0 +1,245 @@
+package cases
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"slices"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// ErrInvalidQuery is returned for invalid parameters of a list, such as a malformed cursor.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// QueryError describes an invalid parameter of a list. It wraps ErrInvalidQuery.
+type QueryError struct {
+	Param  string
+	Reason string
+}
+
+func (e *QueryError) Error() string {
+	return ErrInvalidQuery.Error() + ": " + e.Param + ": " + e.Reason
+}
+
+func (e *QueryError) Unwrap() error {
+	return ErrInvalidQuery
+}
+
+// executionStatuses are the statuses an execution can have.
+var executionStatuses = []repo.Status{
+	repo.Queued, repo.Running, repo.Completed, repo.Failed, repo.TimedOut, repo.Cancelled, repo.Skipped,
+}
+
+// jobSorts maps the sort fields of the API to the columns of the repository.
+var jobSorts = map[string]string{
+	entity.JobSortCreatedAt:      repo.SortCreatedAt,
+	entity.JobSortLastFinishedAt: repo.SortLastFinishedAt,
+	entity.JobSortNextRunAt:      repo.SortNextRunAt,
+}
+
+// cursor is the position of the last item of a page, encoded into an opaque string.
+// The sort and the order are kept to reject the cursor of another list.
+type cursor struct {
+	Sort  string `json:"s"`
+	Order string `json:"o"`
+	Key   int64  `json:"k"`
+	ID    string `json:"i"`
+}
+
+func (c cursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor parses the cursor of a page of the list sorted by sort in order.
+func decodeCursor(s string, sort string, order string) (*cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, &QueryError{Param: "cursor", Reason: "malformed cursor"}
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return nil, &QueryError{Param: "cursor", Reason: "malformed cursor"}
+	}
+	if c.Sort != sort || c.Order != order {
+		return nil, &QueryError{Param: "cursor", Reason: "cursor of a list with another sort or order"}
+	}
+	return &c, nil
+}
+
+// pageSize checks the requested size of a page, 0 means the default.
+func pageSize(limit int) (uint64, error) {
+	switch {
+	case limit == 0:
+		return defaultPageSize, nil
+	case limit < 0 || limit > maxPageSize:
+		return 0, &QueryError{Param: "limit", Reason: fmt.Sprintf("must be between 1 and %d", maxPageSize)}
+	}
+	return uint64(limit), nil
+}
+
+// sortOrder checks the order of a list, empty means ascending.
+func sortOrder(order string) (string, error) {
+	switch order {
+	case "":
+		return entity.SortAsc, nil
+	case entity.SortAsc, entity.SortDesc:
+		return order, nil
+	}
+	return "", &QueryError{Param: "order", Reason: "must be asc or desc"}
+}
+
+// List returns a page of jobs. The next page is read with NextCursor and the same sort and order.
+func (r *SchedulerCase) List(ctx context.Context, query entity.JobsQuery) (entity.JobsPage, error) {
+	dto, err := toJobsQueryDTO(&query)
+	if err != nil {
+		return entity.JobsPage{}, err
+	}
+
+	// Лишнее задание показывает, есть ли следующая страница
+	limit := dto.Limit
+	dto.Limit++
+	jobsDTO, err := r.jobsRepo.List(ctx, dto)
+	if err != nil {
+		return entity.JobsPage{}, fmt.Errorf("List read error:%w", err)
+	}
+
+	var page entity.JobsPage
+	if uint64(len(jobsDTO)) > limit {
+		jobsDTO = jobsDTO[:limit]
+		last := &jobsDTO[len(jobsDTO)-1]
+		page.NextCursor = cursor{Sort: query.Sort, Order: query.Order, Key: last.SortKey(dto.Sort), ID: last.ID}.encode()
+	}
+	page.Jobs = make([]entity.Job, 0, len(jobsDTO))
+	for i := range jobsDTO {
+		page.Jobs = append(page.Jobs, dtoToEntity(&jobsDTO[i]))
+	}
+	return page, nil
+}
+
+// ListExecutions returns a page of the executions of the job.
+// The next page is read with NextCursor and the same order.
+func (r *SchedulerCase) ListExecutions(ctx context.Context, jobID string, query entity.ExecutionsQuery) (entity.ExecutionsPage, error) {
+	if jobID == "" {
+		return entity.ExecutionsPage{}, ErrInvalidJob
+	}
+	dto, err := toExecutionsQueryDTO(&query)
+	if err != nil {
+		return entity.ExecutionsPage{}, err
+	}
+	if _, err := r.jobsRepo.Read(ctx, jobID); err != nil {
+		return entity.ExecutionsPage{}, mapExecutionError("listExecution", err)
+	}
+
+	limit := dto.Limit
+	dto.Limit++
+	execDTOs, err := r.jobsRepo.ListExecutions(ctx, jobID, dto)
+	if err != nil {
+		return entity.ExecutionsPage{}, fmt.Errorf("listExecution:%w", err)
+	}
+
+	var page entity.ExecutionsPage
+	if uint64(len(execDTOs)) > limit {
+		execDTOs = execDTOs[:limit]
+		last := &execDTOs[len(execDTOs)-1]
+		page.NextCursor = cursor{Sort: entity.ExecutionSortStartedAt, Order: query.Order, Key: last.SortKey(), ID: last.ID}.encode()
+	}
+	page.Executions = make([]entity.Execution, 0, len(execDTOs))
+	for i := range execDTOs {
+		page.Executions = append(page.Executions, execDTOToEntity(&execDTOs[i]))
+	}
+	return page, nil
+}
+
+// toJobsQueryDTO checks the query and fills in the defaults of its sort and order,
+// which are kept in the cursor.
+func toJobsQueryDTO(query *entity.JobsQuery) (*repo.JobsQueryDTO, error) {
+	if query.Sort == "" {
+		query.Sort = entity.JobSortCreatedAt
+	}
+	sort, ok := jobSorts[query.Sort]
+	if !ok {
+		return nil, &QueryError{Param: "sort", Reason: "must be createdAt, lastFinishedAt or nextRunAt"}
+	}
+	order, err := sortOrder(query.Order)
+	if err != nil {
+		return nil, err
+	}
+	query.Order = order
+	limit, err := pageSize(query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	switch query.Schedule {
+	case "", entity.ScheduleOnce, entity.ScheduleInterval, entity.ScheduleCron:
+	default:
+		return nil, &QueryError{Param: "schedule", Reason: "must be once, interval or cron"}
+	}
+
+	var labels []repo.LabelRequirementDTO
+	if query.Selector != "" {
+		if labels, err = parseSelector(query.Selector); err != nil {
+			return nil, err
+		}
+	}
+
+	dto := &repo.JobsQueryDTO{
+		Labels:        labels,
+		Status:        query.Status,
+		Schedule:      query.Schedule,
+		CreatedAfter:  query.CreatedAfter,
+		CreatedBefore: query.CreatedBefore,
+		Sort:          sort,
+		Desc:          query.Order == entity.SortDesc,
+		Limit:         limit,
+	}
+	if query.Cursor != "" {
+		c, err := decodeCursor(query.Cursor, query.Sort, query.Order)
+		if err != nil {
+			return nil, err
+		}
+		dto.After = &repo.CursorDTO{Key: c.Key, ID: c.ID}
+	}
+	return dto, nil
+}
+
+// toExecutionsQueryDTO checks the query and fills in the default of its order, which is kept in the cursor.
+func toExecutionsQueryDTO(query *entity.ExecutionsQuery) (*repo.ExecutionsQueryDTO, error) {
+	order, err := sortOrder(query.Order)
+	if err != nil {
+		return nil, err
+	}
+	query.Order = order
+	limit, err := pageSize(query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if query.Status != nil && !slices.Contains(executionStatuses, repo.Status(*query.Status)) {
+		return nil, &QueryError{Param: "status", Reason: "unknown execution status"}
+	}
+
+	dto := &repo.ExecutionsQueryDTO{
+		WorkerID:      query.WorkerID,
+		Status:        query.Status,
+		StartedAfter:  query.StartedAfter,
+		StartedBefore: query.StartedBefore,
+		Desc:          query.Order == entity.SortDesc,
+		Limit:         limit,
+	}
+	if query.Cursor != "" {
+		c, err := decodeCursor(query.Cursor, entity.ExecutionSortStartedAt, query.Order)
+		if err != nil {
+			return nil, err
+		}
+		dto.After = &repo.CursorDTO{Key: c.Key, ID: c.ID}
+	}
+	return dto, nil
+}
@@ -0,0 +1,117 @@
+package cases
+
+import (
+	"context"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"time"
+)
+
+const (
+	maxLogChunks   = 100
+	maxLogChunkLen = 64 << 10
+	logsBatchSize  = 1000
+
+	// logPollInterval is how often followed logs are checked for new chunks
+	logPollInterval = 500 * time.Millisecond
+)
+
+// AppendLogs stores chunks of the output reported by the worker holding the execution.
+// Logs are accepted only while the execution runs.
+func (r *SchedulerCase) AppendLogs(ctx context.Context, execID string, workerID string, logs []entity.ExecutionLog) error {
+	if execID == "" || workerID == "" || len(logs) == 0 || len(logs) > maxLogChunks {
+		return ErrInvalidJob
+	}
+
+	dtos := make([]repo.ExecutionLogDTO, 0, len(logs))
+	for _, l := range logs {
+		if l.Stream == "" {
+			l.Stream = entity.LogStdout
+		}
+		if (l.Stream != entity.LogStdout && l.Stream != entity.LogStderr) || len(l.Data) > maxLogChunkLen {
+			return ErrInvalidJob
+		}
+		dtos = append(dtos, repo.ExecutionLogDTO{ExecutionID: execID, Stream: l.Stream, Data: l.Data})
+	}
+
+	if err := r.jobsRepo.AppendExecutionLogs(ctx, execID, workerID, time.Now().UnixMilli(), dtos); err != nil {
+		return mapExecutionError("append logs", err)
+	}
+	return nil
+}
+
+// ExecutionLogs returns the chunks of the output of the execution which follow the chunk after,
+// a batch at a time.
+func (r *SchedulerCase) ExecutionLogs(ctx context.Context, execID string, after int64) ([]entity.ExecutionLog, error) {
+	if execID == "" {
+		return nil, ErrInvalidJob
+	}
+	if _, err := r.jobsRepo.ReadExecution(ctx, execID); err != nil {
+		return nil, mapExecutionError("execution logs", err)
+	}
+
+	dtos, err := r.jobsRepo.ListExecutionLogs(ctx, execID, after, logsBatchSize)
+	if err != nil {
+		return nil, mapExecutionError("execution logs", err)
+	}
+	logs := make([]entity.ExecutionLog, 0, len(dtos))
+	for i := range dtos {
+		logs = append(logs, logDTOToEntity(&dtos[i]))
+	}
+	return logs, nil
+}
+
+// FollowExecutionLogs sends the chunks which follow the chunk after as they are appended
+// until the execution finishes, and returns the finished execution.
+// It stops early when ctx is cancelled or send fails.
+func (r *SchedulerCase) FollowExecutionLogs(ctx context.Context, execID string, after int64, send func(entity.ExecutionLog) error) (entity.Execution, error) {
+	if execID == "" {
+		return entity.Execution{}, ErrInvalidJob
+	}
+
+	ticker := time.NewTicker(logPollInterval)
+	defer ticker.Stop()
+	for {
+		// Статус читается раньше логов: после завершения выполнения куски больше не добавляются,
+		// так что выборка после него последняя
+		exec, err := r.jobsRepo.ReadExecution(ctx, execID)
+		if err != nil {
+			return entity.Execution{}, mapExecutionError("follow logs", err)
+		}
+		finished := exec.Status != string(repo.Queued) && exec.Status != string(repo.Running)
+
+		for {
+			dtos, err := r.jobsRepo.ListExecutionLogs(ctx, execID, after, logsBatchSize)
+			if err != nil {
+				return entity.Execution{}, mapExecutionError("follow logs", err)
+			}
+			for i := range dtos {
+				if err := send(logDTOToEntity(&dtos[i])); err != nil {
+					return entity.Execution{}, err
+				}
+				after = dtos[i].Seq
+			}
+			if len(dtos) < logsBatchSize {
+				break
+			}
+		}
+
+		if finished {
+			return execDTOToEntity(exec), nil
+		}
+		select {
+		case <-ctx.Done():
+			return entity.Execution{}, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func logDTOToEntity(l *repo.ExecutionLogDTO) entity.ExecutionLog {
+	return entity.ExecutionLog{
+		Seq:       l.Seq,
+		Stream:    l.Stream,
+		Data:      l.Data,
+		CreatedAt: l.CreatedAt,
+	}
+}
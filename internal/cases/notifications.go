@@ -0,0 +1,257 @@
+package cases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultNotifierInterval = time.Second
+	notificationsBatchSize  = 100
+
+	// notificationClaimTTL is how long a claimed delivery waits before another notifier retries it,
+	// e.g. when the replica delivering it crashed
+	notificationClaimTTL = time.Minute
+
+	maxNotificationAttempts     = 10
+	notificationInitialDelay    = 5 * time.Second
+	notificationMaxDelay        = time.Hour
+	notificationBackoffMultiple = 2
+)
+
+// NotificationSender calls the webhook of a delivery.
+type NotificationSender interface {
+	// Send returns the status code of the response, an error for a failed call or a status other than 2xx.
+	Send(ctx context.Context, delivery *repo.NotificationDTO) (int, error)
+}
+
+// notificationBody is the JSON body of a webhook call.
+type notificationBody struct {
+	Event       entity.NotificationEvent `json:"event"`
+	JobID       string                   `json:"jobId"`
+	ExecutionID string                   `json:"executionId"`
+	Attempt     int                      `json:"attempt"`
+	Status      string                   `json:"status"`
+	Reason      string                   `json:"reason,omitempty"`
+	Error       string                   `json:"error,omitempty"`
+	OccurredAt  int64                    `json:"occurredAt"`
+}
+
+// ListNotifications returns the deliveries of the notifications of the job, the latest first.
+func (r *SchedulerCase) ListNotifications(ctx context.Context, jobID string) ([]entity.NotificationDelivery, error) {
+	if jobID == "" {
+		return nil, ErrInvalidJob
+	}
+	if _, err := r.jobsRepo.Read(ctx, jobID); err != nil {
+		return nil, mapExecutionError("list notifications", err)
+	}
+
+	dtos, err := r.jobsRepo.ListNotifications(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("list notifications error:%w", err)
+	}
+	deliveries := make([]entity.NotificationDelivery, 0, len(dtos))
+	for i := range dtos {
+		deliveries = append(deliveries, notificationDTOToEntity(&dtos[i]))
+	}
+	return deliveries, nil
+}
+
+// notificationsFor queues the deliveries of the events of the finished execution
+// to the webhooks of the job subscribed to them.
+func notificationsFor(hooks []entity.Notification, exec *repo.ExecutionDTO, deadLetter *repo.DeadLetterDTO, now time.Time) []repo.NotificationDTO {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	var events []entity.NotificationEvent
+	switch repo.Status(exec.Status) {
+	case repo.Completed:
+		events = append(events, entity.EventSucceeded)
+	case repo.Failed:
+		events = append(events, entity.EventFailed)
+	case repo.TimedOut:
+		events = append(events, entity.EventTimedOut)
+	}
+	if deadLetter != nil {
+		events = append(events, entity.EventDeadLettered)
+	}
+
+	var notifications []repo.NotificationDTO
+	for _, event := range events {
+		body, _ := json.Marshal(notificationBody{
+			Event:       event,
+			JobID:       exec.JobID,
+			ExecutionID: exec.ID,
+			Attempt:     exec.Attempt,
+			Status:      exec.Status,
+			Reason:      exec.Reason,
+			Error:       exec.Error,
+			OccurredAt:  now.UnixMilli(),
+		})
+		for _, hook := range hooks {
+			if !hook.Subscribed(event) {
+				continue
+			}
+			notifications = append(notifications, repo.NotificationDTO{
+				ID:            uuid.NewString(),
+				JobID:         exec.JobID,
+				ExecutionID:   exec.ID,
+				Event:         string(event),
+				URL:           hook.URL,
+				Secret:        hook.Secret,
+				Body:          body,
+				Status:        entity.DeliveryPending,
+				CreatedAt:     now.UnixMilli(),
+				NextAttemptAt: now.UnixMilli(),
+			})
+		}
+	}
+	return notifications
+}
+
+// Notifier delivers the queued notifications and retries failed deliveries with a backoff.
+// Deliveries are claimed for a while before the call, so notifiers of all replicas
+// may run at once and a delivery lost with its replica is retried by another one.
+type Notifier struct {
+	jobsRepo JobsRepo
+	sender   NotificationSender
+	logger   *zap.Logger
+	interval time.Duration
+}
+
+func NewNotifier(jobsRepo JobsRepo, sender NotificationSender, logger *zap.Logger, interval time.Duration) *Notifier {
+	if interval <= 0 {
+		interval = defaultNotifierInterval
+	}
+	return &Notifier{
+		jobsRepo: jobsRepo,
+		sender:   sender,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Run delivers notifications until ctx is cancelled.
+func (n *Notifier) Run(ctx context.Context) {
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		n.deliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (n *Notifier) deliverDue(ctx context.Context) {
+	now := time.Now()
+	deliveries, err := n.jobsRepo.ClaimNotifications(ctx, now.UnixMilli(), now.Add(notificationClaimTTL).UnixMilli(), notificationsBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			n.logger.Error("claim notifications", zap.Error(err))
+		}
+		return
+	}
+
+	for i := range deliveries {
+		n.deliver(ctx, &deliveries[i])
+	}
+}
+
+func (n *Notifier) deliver(ctx context.Context, delivery *repo.NotificationDTO) {
+	status, err := n.sender.Send(ctx, delivery)
+	if err != nil && ctx.Err() != nil {
+		// The claim expires and another notifier retries the delivery
+		return
+	}
+
+	now := time.Now()
+	delivery.LastStatusCode = status
+	switch {
+	case err == nil:
+		delivery.Status = entity.DeliveryDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = now.UnixMilli()
+		delivery.NextAttemptAt = 0
+	case delivery.Attempts >= maxNotificationAttempts:
+		n.logger.Warn("notification dropped", zap.String("delivery_id", delivery.ID), zap.String("job_id", delivery.JobID), zap.Error(err))
+		delivery.Status = entity.DeliveryFailed
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = 0
+	default:
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = now.Add(notificationDelay(delivery.Attempts)).UnixMilli()
+	}
+
+	if err := n.jobsRepo.FinishNotification(ctx, delivery); err != nil {
+		n.logger.Error("finish notification", zap.String("delivery_id", delivery.ID), zap.Error(err))
+	}
+}
+
+// notificationDelay returns how long to wait before the attempt following the given one.
+func notificationDelay(attempt int) time.Duration {
+	delay := notificationInitialDelay
+	for i := 1; i < attempt && delay < notificationMaxDelay; i++ {
+		delay *= notificationBackoffMultiple
+	}
+	return min(delay, notificationMaxDelay)
+}
+
+func notificationsToDTO(hooks []entity.Notification) []repo.NotificationHookDTO {
+	if len(hooks) == 0 {
+		return nil
+	}
+	dtos := make([]repo.NotificationHookDTO, 0, len(hooks))
+	for _, h := range hooks {
+		events := make([]string, 0, len(h.Events))
+		for _, e := range h.Events {
+			events = append(events, string(e))
+		}
+		dtos = append(dtos, repo.NotificationHookDTO{URL: h.URL, Secret: h.Secret, Events: events})
+	}
+	return dtos
+}
+
+func notificationsToEntity(dtos []repo.NotificationHookDTO) []entity.Notification {
+	if len(dtos) == 0 {
+		return nil
+	}
+	hooks := make([]entity.Notification, 0, len(dtos))
+	for _, d := range dtos {
+		events := make([]entity.NotificationEvent, 0, len(d.Events))
+		for _, e := range d.Events {
+			events = append(events, entity.NotificationEvent(e))
+		}
+		hooks = append(hooks, entity.Notification{URL: d.URL, Secret: d.Secret, Events: events})
+	}
+	return hooks
+}
+
+func notificationDTOToEntity(n *repo.NotificationDTO) entity.NotificationDelivery {
+	return entity.NotificationDelivery{
+		ID:             n.ID,
+		JobID:          n.JobID,
+		ExecutionID:    n.ExecutionID,
+		Event:          entity.NotificationEvent(n.Event),
+		URL:            n.URL,
+		Status:         n.Status,
+		Attempts:       n.Attempts,
+		LastStatusCode: n.LastStatusCode,
+		LastError:      n.LastError,
+		CreatedAt:      n.CreatedAt,
+		NextAttemptAt:  n.NextAttemptAt,
+		DeliveredAt:    n.DeliveredAt,
+	}
+}
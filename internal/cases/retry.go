@@ -0,0 +1,66 @@
+package cases
+
+import (
+	"errors"
+	"math/rand/v2"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ReasonRetry marks executions queued by the retry policy of the job.
+	ReasonRetry = "retry"
+	// ReasonTimeout marks executions which exceeded the timeout of the job, it is also their error class.
+	ReasonTimeout = "timeout"
+	// ErrorClassUnknown is the error class of executor errors which do not implement ClassifiedError.
+	ErrorClassUnknown = "error"
+)
+
+// ClassifiedError is implemented by executor errors which carry an error class
+// to be matched against the retry policy of the job.
+type ClassifiedError interface {
+	error
+	ErrorClass() string
+}
+
+func errorClass(err error) string {
+	var classified ClassifiedError
+	if errors.As(err, &classified) {
+		return classified.ErrorClass()
+	}
+	return ErrorClassUnknown
+}
+
+// failExecution marks exec as failed with status and reason and returns what follows it:
+// the next attempt, or the dead letter of the run when the retry policy is exhausted.
+func failExecution(policy *entity.RetryPolicy, exec *repo.ExecutionDTO, status repo.Status, reason string, now time.Time) (*repo.ExecutionDTO, *repo.DeadLetterDTO) {
+	exec.Status = string(status)
+	exec.Reason = reason
+	exec.FinishedAt = now.UnixMilli()
+	if retry := retryExecution(policy, exec, now); retry != nil {
+		return retry, nil
+	}
+	return nil, deadLetterFor(exec, now)
+}
+
+// retryExecution returns the execution which retries the failed one,
+// or nil when the retry policy of the job is exhausted.
+func retryExecution(policy *entity.RetryPolicy, failed *repo.ExecutionDTO, now time.Time) *repo.ExecutionDTO {
+	if !policy.CanRetry(failed.Attempt, failed.Reason) {
+		return nil
+	}
+	return &repo.ExecutionDTO{
+		ID:            uuid.NewString(),
+		JobID:         failed.JobID,
+		Status:        string(repo.Queued),
+		Attempt:       failed.Attempt + 1,
+		Trigger:       failed.Trigger,
+		Payload:       failed.Payload,
+		WorkflowRunID: failed.WorkflowRunID,
+		ScheduledAt:   now.Add(policy.Delay(failed.Attempt, rand.Float64())).UnixMilli(),
+		Reason:        ReasonRetry,
+	}
+}
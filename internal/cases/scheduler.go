@@ -16,40 +16,119 @@ type JobsRepo interface {
 	Create(ctx context.Context, job *repo.JobDTO) error
 	Read(ctx context.Context, jobID string) (*repo.JobDTO, error)
 	Delete(ctx context.Context, jobID string) error
-	List(ctx context.Context, status *string) ([]repo.JobDTO, error)
-	ListExecutions(ctx context.Context, jobID string, workerID *string) ([]repo.ExecutionDTO, error)
+	List(ctx context.Context, query *repo.JobsQueryDTO) ([]repo.JobDTO, error)
+	ListExecutions(ctx context.Context, jobID string, query *repo.ExecutionsQueryDTO) ([]repo.ExecutionDTO, error)
+	ListDue(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.JobDTO, error)
+	StartExecution(ctx context.Context, exec *repo.ExecutionDTO, dueAt *int64, nextRunAt *int64, skipped []repo.ExecutionDTO) ([]string, error)
+	SkipFires(ctx context.Context, jobID string, dueAt int64, nextRunAt *int64, skipped []repo.ExecutionDTO) error
+	FinishExecution(ctx context.Context, exec *repo.ExecutionDTO, retry *repo.ExecutionDTO, deadLetter *repo.DeadLetterDTO, notifications []repo.NotificationDTO) error
+	ReadExecution(ctx context.Context, execID string) (*repo.ExecutionDTO, error)
+	LeaseExecutions(ctx context.Context, workerID string, types []string, now int64, leaseExpiresAt int64, limit uint64) ([]repo.LeaseDTO, error)
+	Heartbeat(ctx context.Context, execID string, workerID string, now int64, leaseExpiresAt int64) (bool, error)
+	ListTimedOut(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.ExecutionDTO, error)
+	ListExpiredLeases(ctx context.Context, now int64, shards *repo.ShardsDTO, limit uint64) ([]repo.ExecutionDTO, error)
+	RequeueExecution(ctx context.Context, expired *repo.ExecutionDTO, next *repo.ExecutionDTO) error
+	PauseJob(ctx context.Context, jobID string) error
+	ResumeJob(ctx context.Context, jobID string, misfirePolicy *string) error
+	ListDeadLetters(ctx context.Context) ([]repo.DeadLetterDTO, error)
+	RequeueDeadLetter(ctx context.Context, deadLetterID string, exec *repo.ExecutionDTO) error
+	DeleteDeadLetter(ctx context.Context, deadLetterID string) error
+	CreateWorkflow(ctx context.Context, workflow *repo.WorkflowDTO, jobs []repo.JobDTO) error
+	ReadWorkflow(ctx context.Context, workflowID string) (*repo.WorkflowDTO, error)
+	StartWorkflowRun(ctx context.Context, run *repo.WorkflowRunDTO, execs []repo.ExecutionDTO) error
+	ReadWorkflowRun(ctx context.Context, runID string) (*repo.WorkflowRunDTO, error)
+	ListActiveWorkflowRuns(ctx context.Context, shards *repo.ShardsDTO, limit uint64) ([]repo.WorkflowRunDTO, error)
+	ListWorkflowRunExecutions(ctx context.Context, runID string) ([]repo.ExecutionDTO, error)
+	AdvanceWorkflowRun(ctx context.Context, run *repo.WorkflowRunDTO, execs []repo.ExecutionDTO) error
+	AppendExecutionLogs(ctx context.Context, execID string, workerID string, now int64, logs []repo.ExecutionLogDTO) error
+	ListExecutionLogs(ctx context.Context, execID string, after int64, limit uint64) ([]repo.ExecutionLogDTO, error)
+	ListNotifications(ctx context.Context, jobID string) ([]repo.NotificationDTO, error)
+	ClaimNotifications(ctx context.Context, now int64, claimUntil int64, limit uint64) ([]repo.NotificationDTO, error)
+	FinishNotification(ctx context.Context, notification *repo.NotificationDTO) error
 }
 
 var (
 	ErrNotFound   = errors.New("not found")
 	ErrInvalidJob = errors.New("invalid job data")
+	ErrConflict   = errors.New("conflict")
 )
 
 type SchedulerCase struct {
-	jobsRepo JobsRepo
+	jobsRepo     JobsRepo
+	leaseTTL     time.Duration
+	execCommands []string // commands exec jobs may run, none by default
 }
 
-func NewSchedulerCase(jobsRepo JobsRepo) *SchedulerCase {
+func NewSchedulerCase(jobsRepo JobsRepo, leaseTTL time.Duration, execCommands []string) *SchedulerCase {
+	if leaseTTL <= 0 {
+		leaseTTL = defaultLeaseTTL
+	}
 	return &SchedulerCase{
-		jobsRepo: jobsRepo,
+		jobsRepo:     jobsRepo,
+		leaseTTL:     leaseTTL,
+		execCommands: execCommands,
 	}
 }
 
 // Create creates a new job and returns its ID.
 func (r *SchedulerCase) Create(ctx context.Context, job *entity.Job) (string, error) {
+	schedule, err := validateJob(job, r.execCommands)
+	if err != nil {
+		return "", err
+	}
+
+	// Once is stored as an instant, a local date-time is resolved in the job time zone
+	if once, ok := schedule.(entity.OnceSchedule); ok {
+		job.Once = once.At.Format(time.RFC3339)
+	}
+	if job.Timezone == "" {
+		job.Timezone = time.UTC.String()
+	}
+	job.Type, _ = entity.ParseJobType(string(job.Type))                                     // checked by validateJob
+	job.MisfirePolicy, _ = entity.ParseMisfirePolicy(string(job.MisfirePolicy))             // checked by validateJob
+	job.ConcurrencyPolicy, _ = entity.ParseConcurrencyPolicy(string(job.ConcurrencyPolicy)) // checked by validateJob
+	var timeout *int64
+	if job.Timeout != "" {
+		d, _ := entity.ParseTimeout(job.Timeout) // checked by validateJob
+		ms := d.Milliseconds()
+		timeout = &ms
+	}
+
+	now := time.Now()
 	job.ID = uuid.NewString()
-	job.CreatedAt = time.Now().UnixMilli()
-	job.Status = "queued" // Default status for new jobs
+	job.CreatedAt = now.UnixMilli()
+	job.Status = entity.Queued // Default status for new jobs
+
+	next, ok := schedule.Next(now)
+	if !ok {
+		// A once job whose time has already passed fires right away,
+		// other schedules which never fire are rejected by validateJob
+		if _, once := schedule.(entity.OnceSchedule); !once {
+			return "", &ValidationError{Fields: []entity.FieldError{{Field: "cron", Reason: "never fires"}}}
+		}
+		next = now
+	}
+	job.NextRunAt = next.UnixMilli()
 
 	// Convert entity.Job to repo.JobDTO
 	jobDTO := &repo.JobDTO{
-		ID:             job.ID,
-		Once:           &job.Once,
-		Interval:       &job.Interval,
-		Status:         repo.Status(job.Status),
-		CreatedAt:      job.CreatedAt,
-		LastFinishedAt: job.LastFinishedAt,
-		Payload:        job.Payload,
+		ID:                job.ID,
+		Type:              string(job.Type),
+		Once:              pointers.NilIfEmpty(job.Once),
+		Interval:          pointers.NilIfEmpty(job.Interval),
+		Cron:              pointers.NilIfEmpty(job.Cron),
+		Status:            repo.Status(job.Status),
+		CreatedAt:         job.CreatedAt,
+		LastFinishedAt:    job.LastFinishedAt,
+		NextRunAt:         &job.NextRunAt,
+		Payload:           job.Payload,
+		Timezone:          job.Timezone,
+		RetryPolicy:       retryPolicyToDTO(job.RetryPolicy),
+		Timeout:           timeout,
+		MisfirePolicy:     string(job.MisfirePolicy),
+		ConcurrencyPolicy: string(job.ConcurrencyPolicy),
+		Notifications:     notificationsToDTO(job.Notifications),
+		Labels:            job.Labels,
 	}
 
 	// Save to repository
@@ -76,15 +155,7 @@ func (r *SchedulerCase) GetOneByID(ctx context.Context, jobID string) (entity.Jo
 	}
 
 	// Convert repo.JobDTO to entity.Job
-	return entity.Job{
-		ID:             jobDTO.ID,
-		Once:           *jobDTO.Once,
-		Interval:       *jobDTO.Interval,
-		Status:         entity.Status(jobDTO.Status),
-		CreatedAt:      jobDTO.CreatedAt,
-		LastFinishedAt: jobDTO.LastFinishedAt,
-		Payload:        jobDTO.Payload,
-	}, nil
+	return dtoToEntity(jobDTO), nil
 }
 
 func (r *SchedulerCase) Delete(ctx context.Context, jobID string) error {
@@ -100,51 +171,154 @@ func (r *SchedulerCase) Delete(ctx context.Context, jobID string) error {
 	return nil
 }
 
-func (r *SchedulerCase) List(ctx context.Context, status *string) ([]entity.Job, error) {
-	jobsDTO, err := r.jobsRepo.List(ctx, status)
+// Trigger queues an execution of the job right away and returns its ID.
+// The keys of payload replace the ones of the job payload for this execution only,
+// the result must be a valid payload of the job type.
+// The schedule of the job is not changed.
+func (r *SchedulerCase) Trigger(ctx context.Context, jobID string, payload map[string]any) (string, error) {
+	if jobID == "" {
+		return "", ErrInvalidJob
+	}
+
+	job, err := r.jobsRepo.Read(ctx, jobID)
 	if err != nil {
-		return nil, fmt.Errorf("List read error:%w", err)
+		return "", mapExecutionError("trigger", err)
 	}
 
-	jobs := make([]entity.Job, 0, len(jobsDTO))
-	for _, j := range jobsDTO {
-		jobs = append(jobs, dtoToEntity(&j))
+	exec := &repo.ExecutionDTO{
+		ID:          uuid.NewString(),
+		JobID:       jobID,
+		Status:      string(repo.Queued),
+		Attempt:     1,
+		Trigger:     entity.TriggerManual,
+		ScheduledAt: time.Now().UnixMilli(),
+	}
+	if len(payload) > 0 {
+		exec.Payload = mergePayload(job.Payload, payload)
+		// The override is checked as the payload of a new job, so that a bad one is rejected
+		// now rather than failed, retried and dead-lettered by the executor
+		triggered := dtoToEntity(job)
+		triggered.Payload = exec.Payload
+		if errs := validateJobType(&triggered, r.execCommands); len(errs) > 0 {
+			return "", &ValidationError{Fields: errs}
+		}
 	}
-	return jobs, fmt.Errorf("List error:%w", err)
+
+	// The concurrency policy of the job applies as to a scheduled run
+	if _, err := r.jobsRepo.StartExecution(ctx, exec, nil, nil, nil); err != nil {
+		return "", mapExecutionError("trigger", err)
+	}
+	return exec.ID, nil
 }
 
-func (r *SchedulerCase) ListExecutions(ctx context.Context, jobID string, workerID *string) ([]entity.Execution, error) {
+// Pause stops firing the job until it is resumed. An execution in progress is not interrupted,
+// queued ones wait for the job to be resumed.
+func (r *SchedulerCase) Pause(ctx context.Context, jobID string) error {
 	if jobID == "" {
-		return nil, ErrInvalidJob
+		return ErrInvalidJob
 	}
+	if err := r.jobsRepo.PauseJob(ctx, jobID); err != nil {
+		return mapExecutionError("pause", err)
+	}
+	return nil
+}
 
-	execDTOs, err := r.jobsRepo.ListExecutions(ctx, jobID, workerID)
-	if err != nil {
-		return nil, fmt.Errorf("listExecution:%w", err)
+// Resume lets the paused job fire again. The engine handles fire times missed while
+// the job was paused according to the misfire policy of the job, which is replaced
+// by policy unless it is empty.
+func (r *SchedulerCase) Resume(ctx context.Context, jobID string, policy entity.MisfirePolicy) error {
+	if jobID == "" {
+		return ErrInvalidJob
+	}
+	if err := r.jobsRepo.ResumeJob(ctx, jobID, pointers.NilIfEmpty(string(policy))); err != nil {
+		return mapExecutionError("resume", err)
 	}
+	return nil
+}
 
-	execs := make([]entity.Execution, 0, len(execDTOs))
-	for _, e := range execDTOs {
-		execs = append(execs, entity.Execution{
-			Id:         e.ID,
-			JobId:      e.JobID,
-			WorkerId:   e.WorkerID,
-			Status:     e.Status,
-			StartedAt:  e.StartedAt,
-			FinishedAt: e.FinishedAt,
-		})
+// mergePayload returns a copy of base with the top-level keys of override replaced.
+func mergePayload(base, override map[string]any) map[string]any {
+	merged := make(map[string]any, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		merged[k] = v
 	}
-	return execs, fmt.Errorf("listExecution:%w", err)
+	return merged
 }
 
 func dtoToEntity(j *repo.JobDTO) entity.Job {
 	return entity.Job{
-		ID:             j.ID,
-		Once:           pointers.Deref(j.Once),
-		Interval:       pointers.Deref(j.Interval),
-		Status:         entity.Status(j.Status),
-		CreatedAt:      j.CreatedAt,
-		LastFinishedAt: j.LastFinishedAt,
-		Payload:        j.Payload,
+		ID:                j.ID,
+		Type:              entity.JobType(j.Type),
+		Once:              pointers.Deref(j.Once),
+		Interval:          pointers.Deref(j.Interval),
+		Cron:              pointers.Deref(j.Cron),
+		Status:            entity.Status(j.Status),
+		CreatedAt:         j.CreatedAt,
+		LastFinishedAt:    j.LastFinishedAt,
+		NextRunAt:         pointers.DerefInt64(j.NextRunAt),
+		Payload:           j.Payload,
+		Timezone:          j.Timezone,
+		RetryPolicy:       retryPolicyToEntity(j.RetryPolicy),
+		Timeout:           timeoutToEntity(j.Timeout),
+		MisfirePolicy:     entity.MisfirePolicy(j.MisfirePolicy),
+		ConcurrencyPolicy: entity.ConcurrencyPolicy(j.ConcurrencyPolicy),
+		WorkflowID:        pointers.Deref(j.WorkflowID),
+		Notifications:     notificationsToEntity(j.Notifications),
+		Labels:            j.Labels,
+	}
+}
+
+func timeoutToEntity(ms *int64) string {
+	if ms == nil {
+		return ""
+	}
+	return (time.Duration(*ms) * time.Millisecond).String()
+}
+
+func retryPolicyToDTO(p *entity.RetryPolicy) *repo.RetryPolicyDTO {
+	if p == nil {
+		return nil
+	}
+	return &repo.RetryPolicyDTO{
+		MaxAttempts:     p.MaxAttempts,
+		InitialDelay:    p.InitialDelay,
+		Multiplier:      p.Multiplier,
+		MaxDelay:        p.MaxDelay,
+		Jitter:          p.Jitter,
+		RetryableErrors: p.RetryableErrors,
+	}
+}
+
+func retryPolicyToEntity(p *repo.RetryPolicyDTO) *entity.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &entity.RetryPolicy{
+		MaxAttempts:     p.MaxAttempts,
+		InitialDelay:    p.InitialDelay,
+		Multiplier:      p.Multiplier,
+		MaxDelay:        p.MaxDelay,
+		Jitter:          p.Jitter,
+		RetryableErrors: p.RetryableErrors,
+	}
+}
+
+func execDTOToEntity(e *repo.ExecutionDTO) entity.Execution {
+	return entity.Execution{
+		Id:            e.ID,
+		JobId:         e.JobID,
+		WorkerId:      e.WorkerID,
+		Status:        e.Status,
+		Attempt:       e.Attempt,
+		Trigger:       e.Trigger,
+		WorkflowRunId: e.WorkflowRunID,
+		StartedAt:     e.StartedAt,
+		FinishedAt:    e.FinishedAt,
+		Output:        e.Output,
+		Error:         e.Error,
+		Reason:        e.Reason,
 	}
 }
@@ -0,0 +1,97 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"scheduler/internal/adapter/repo/memory"
+	"scheduler/internal/cases"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"testing"
+	"time"
+)
+
+func TestCreateNextRunAt(t *testing.T) {
+	ctx := context.Background()
+	r := memory.NewJobsRepo()
+	sc := cases.NewSchedulerCase(r, 0, nil)
+
+	// Однократное задание, время которого прошло, запускается сразу
+	start := time.Now()
+	id, err := sc.Create(ctx, &entity.Job{Once: "2020-01-01T00:00:00Z", Payload: map[string]any{}})
+	if err != nil {
+		t.Fatalf("create once: %v", err)
+	}
+	if job, _ := r.Read(ctx, id); job.NextRunAt == nil || *job.NextRunAt < start.UnixMilli() || *job.NextRunAt > time.Now().UnixMilli() {
+		t.Errorf("next run of a passed once job = %v, want now", job.NextRunAt)
+	}
+
+	// Корректное выражение cron, которое никогда не срабатывает, отклоняется, а не запускается сразу
+	_, err = sc.Create(ctx, &entity.Job{Cron: "0 0 30 2 *", Payload: map[string]any{}})
+	var verr *cases.ValidationError
+	if !errors.As(err, &verr) || len(verr.Fields) != 1 || verr.Fields[0].Field != "cron" {
+		t.Errorf("create a cron job which never fires: %v, want a cron field error", err)
+	}
+}
+
+func TestCreateExecJobNeedsAllowlist(t *testing.T) {
+	ctx := context.Background()
+	job := func() *entity.Job {
+		return &entity.Job{Type: entity.JobTypeExec, Interval: "1h", Payload: map[string]any{"command": "/bin/echo"}}
+	}
+
+	// Без списка разрешенных команд задания exec выключены
+	_, err := cases.NewSchedulerCase(memory.NewJobsRepo(), 0, nil).Create(ctx, job())
+	var verr *cases.ValidationError
+	if !errors.As(err, &verr) || verr.Fields[0].Field != "type" {
+		t.Errorf("create an exec job without an allowlist: %v, want a type field error", err)
+	}
+
+	sc := cases.NewSchedulerCase(memory.NewJobsRepo(), 0, []string{"/bin/true"})
+	if _, err := sc.Create(ctx, job()); !errors.As(err, &verr) || verr.Fields[0].Field != "payload.command" {
+		t.Errorf("create an exec job of a command not allowed: %v, want a payload.command field error", err)
+	}
+}
+
+func TestTriggerValidatesPayload(t *testing.T) {
+	ctx := context.Background()
+	r := memory.NewJobsRepo()
+	sc := cases.NewSchedulerCase(r, 0, []string{"/bin/echo"})
+	execJob, err := sc.Create(ctx, &entity.Job{Type: entity.JobTypeExec, Interval: "1h", Payload: map[string]any{"command": "/bin/echo"}})
+	if err != nil {
+		t.Fatalf("create exec job: %v", err)
+	}
+	httpJob, err := sc.Create(ctx, &entity.Job{Type: entity.JobTypeHTTP, Interval: "1h", Payload: map[string]any{"url": "https://example.com"}})
+	if err != nil {
+		t.Fatalf("create http job: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		jobID   string
+		payload map[string]any
+		field   string
+	}{
+		{"command not allowed", execJob, map[string]any{"command": "/bin/sh"}, "payload.command"},
+		{"command removed", execJob, map[string]any{"command": ""}, "payload.command"},
+		{"bad url", httpJob, map[string]any{"url": "ftp://example.com"}, "payload.url"},
+		{"bad method", httpJob, map[string]any{"method": "BREW"}, "payload.method"},
+	} {
+		_, err := sc.Trigger(ctx, tc.jobID, tc.payload)
+		var verr *cases.ValidationError
+		if !errors.As(err, &verr) || verr.Fields[0].Field != tc.field {
+			t.Errorf("%s: trigger: %v, want a %s field error", tc.name, err, tc.field)
+		}
+	}
+	// Отклоненный запуск не оставляет выполнений
+	for _, jobID := range []string{execJob, httpJob} {
+		if execs, _ := r.ListExecutions(ctx, jobID, &repo.ExecutionsQueryDTO{Limit: 10}); len(execs) != 0 {
+			t.Errorf("executions of rejected triggers: %+v", execs)
+		}
+	}
+
+	// Корректная подмена принимается
+	if _, err := sc.Trigger(ctx, httpJob, map[string]any{"method": "POST"}); err != nil {
+		t.Errorf("trigger with a valid override: %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package cases
+
+import (
+	"errors"
+	"regexp"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"strings"
+)
+
+// setRequirementRe matches a set-based requirement, such as tier in (a,b) or env notin (dev).
+var setRequirementRe = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+// parseSelector parses a Kubernetes-style label selector, a comma-separated list of requirements
+// which must all hold: key=value, key==value, key!=value, key in (a,b), key notin (a,b),
+// key for an existing label and !key for a missing one.
+func parseSelector(selector string) ([]repo.LabelRequirementDTO, error) {
+	terms, err := splitSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	reqs := make([]repo.LabelRequirementDTO, 0, len(terms))
+	for _, term := range terms {
+		req, err := parseRequirement(term)
+		if err != nil {
+			return nil, &QueryError{Param: "selector", Reason: err.Error()}
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
+
+// splitSelector splits the selector by the commas outside of the value sets.
+func splitSelector(selector string) ([]string, error) {
+	var terms []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, strings.TrimSpace(selector[start:i]))
+				start = i + 1
+			}
+		}
+		if depth < 0 || depth > 1 {
+			return nil, &QueryError{Param: "selector", Reason: "unbalanced parentheses"}
+		}
+	}
+	if depth != 0 {
+		return nil, &QueryError{Param: "selector", Reason: "unbalanced parentheses"}
+	}
+	terms = append(terms, strings.TrimSpace(selector[start:]))
+	for _, term := range terms {
+		if term == "" {
+			return nil, &QueryError{Param: "selector", Reason: "empty requirement"}
+		}
+	}
+	return terms, nil
+}
+
+func parseRequirement(term string) (repo.LabelRequirementDTO, error) {
+	var req repo.LabelRequirementDTO
+	if m := setRequirementRe.FindStringSubmatch(term); m != nil {
+		req.Key, req.Op = m[1], m[2]
+		if strings.TrimSpace(m[3]) == "" {
+			return req, errors.New("empty set of values of " + req.Key)
+		}
+		for _, v := range strings.Split(m[3], ",") {
+			req.Values = append(req.Values, strings.TrimSpace(v))
+		}
+	} else if key, value, ok := strings.Cut(term, "!="); ok {
+		req = repo.LabelRequirementDTO{Key: strings.TrimSpace(key), Op: repo.LabelNotEquals, Values: []string{strings.TrimSpace(value)}}
+	} else if key, value, ok := strings.Cut(term, "=="); ok {
+		req = repo.LabelRequirementDTO{Key: strings.TrimSpace(key), Op: repo.LabelEquals, Values: []string{strings.TrimSpace(value)}}
+	} else if key, value, ok := strings.Cut(term, "="); ok {
+		req = repo.LabelRequirementDTO{Key: strings.TrimSpace(key), Op: repo.LabelEquals, Values: []string{strings.TrimSpace(value)}}
+	} else if key, ok := strings.CutPrefix(term, "!"); ok {
+		req = repo.LabelRequirementDTO{Key: strings.TrimSpace(key), Op: repo.LabelNotExists}
+	} else {
+		req = repo.LabelRequirementDTO{Key: term, Op: repo.LabelExists}
+	}
+
+	if err := entity.CheckLabelKey(req.Key); err != nil {
+		return req, err
+	}
+	for _, v := range req.Values {
+		if err := entity.CheckLabelValue(v); err != nil {
+			return req, err
+		}
+	}
+	return req, nil
+}
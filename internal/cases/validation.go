@@ -0,0 +1,135 @@
+package cases
+
+import (
+	"errors"
+	"fmt"
+	"scheduler/internal/entity"
+	"strings"
+	"time"
+)
+
+// ValidationError lists every invalid field of a job definition.
+// It wraps ErrInvalidJob.
+type ValidationError struct {
+	Fields []entity.FieldError
+}
+
+func (e *ValidationError) Error() string {
+	reasons := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		reasons = append(reasons, f.Field+": "+f.Reason)
+	}
+	return ErrInvalidJob.Error() + ": " + strings.Join(reasons, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidJob
+}
+
+func (e *ValidationError) add(field, reason string) {
+	e.Fields = append(e.Fields, entity.FieldError{Field: field, Reason: reason})
+}
+
+func (e *ValidationError) addErr(field string, err error) {
+	var fieldErr *entity.FieldError
+	if errors.As(err, &fieldErr) {
+		e.Fields = append(e.Fields, *fieldErr)
+		return
+	}
+	e.add(field, err.Error())
+}
+
+// validateJob checks the whole job definition and returns its schedule.
+// Unlike job.Schedule it reports all invalid fields at once.
+// execCommands is the allowlist of the commands exec jobs may run.
+func validateJob(job *entity.Job, execCommands []string) (entity.Schedule, error) {
+	verr := &ValidationError{}
+
+	loc, err := entity.LoadTimezone(job.Timezone)
+	if err != nil {
+		verr.addErr("timezone", err)
+	}
+
+	var set []string
+	if job.Once != "" {
+		set = append(set, "once")
+		// Without a valid time zone a local date-time can't be checked
+		if loc != nil {
+			if _, err := entity.ParseOnce(job.Once, loc); err != nil {
+				verr.addErr("once", err)
+			}
+		}
+	}
+	if job.Interval != "" {
+		set = append(set, "interval")
+		if _, err := entity.ParseInterval(job.Interval); err != nil {
+			verr.addErr("interval", err)
+		}
+	}
+	if job.Cron != "" {
+		set = append(set, "cron")
+		if loc != nil {
+			if cron, err := entity.ParseCron(job.Cron, loc); err != nil {
+				verr.addErr("cron", err)
+			} else if _, ok := cron.Next(time.Now()); !ok {
+				// Such as 0 0 30 2 *, which is valid but can't fire
+				verr.add("cron", fmt.Sprintf("never fires within %d years", entity.MaxCronYears))
+			}
+		}
+	}
+
+	switch len(set) {
+	case 0:
+		for _, field := range []string{"once", "interval", "cron"} {
+			verr.add(field, "one of once, interval or cron is required")
+		}
+	case 1:
+	default:
+		for _, field := range set {
+			verr.add(field, "only one of once, interval or cron may be set")
+		}
+	}
+
+	if job.Timeout != "" {
+		if _, err := entity.ParseTimeout(job.Timeout); err != nil {
+			verr.addErr("timeout", err)
+		}
+	}
+	if _, err := entity.ParseMisfirePolicy(string(job.MisfirePolicy)); err != nil {
+		verr.addErr("misfirePolicy", err)
+	}
+	if _, err := entity.ParseConcurrencyPolicy(string(job.ConcurrencyPolicy)); err != nil {
+		verr.addErr("concurrencyPolicy", err)
+	}
+	if job.RetryPolicy != nil {
+		verr.Fields = append(verr.Fields, job.RetryPolicy.Validate()...)
+	}
+	verr.Fields = append(verr.Fields, validateJobType(job, execCommands)...)
+	for i := range job.Notifications {
+		verr.Fields = append(verr.Fields, job.Notifications[i].Validate(i)...)
+	}
+	verr.Fields = append(verr.Fields, entity.ValidateLabels(job.Labels)...)
+
+	if len(verr.Fields) > 0 {
+		return nil, verr
+	}
+	return job.Schedule()
+}
+
+// validateJobType checks the type of the job and the payload it requires.
+func validateJobType(job *entity.Job, execCommands []string) []entity.FieldError {
+	jobType, err := entity.ParseJobType(string(job.Type))
+	var fieldErr *entity.FieldError
+	if errors.As(err, &fieldErr) {
+		return []entity.FieldError{*fieldErr}
+	}
+	switch jobType {
+	case entity.JobTypeHTTP:
+		_, errs := entity.ParseHTTPRequest(job.Payload)
+		return errs
+	case entity.JobTypeExec:
+		_, errs := entity.ParseExecRequest(job.Payload, execCommands)
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"time"
+)
+
+const (
+	maxLeaseLimit   = 100
+	defaultLeaseTTL = 30 * time.Second
+
+	// ReasonLeaseExpired marks executions taken away from a worker that stopped sending heartbeats.
+	ReasonLeaseExpired = "lease_expired"
+)
+
+// Lease hands up to limit queued executions over to the worker.
+func (r *SchedulerCase) Lease(ctx context.Context, workerID string, limit int) ([]entity.Lease, error) {
+	if workerID == "" || limit < 1 || limit > maxLeaseLimit {
+		return nil, ErrInvalidJob
+	}
+
+	now := time.Now()
+	leaseExpiresAt := now.Add(r.leaseTTL).UnixMilli()
+	// Jobs of the other types are run by the engine
+	workerTypes := []string{string(entity.JobTypeWorker)}
+	leaseDTOs, err := r.jobsRepo.LeaseExecutions(ctx, workerID, workerTypes, now.UnixMilli(), leaseExpiresAt, uint64(limit))
+	if err != nil {
+		return nil, fmt.Errorf("lease error:%w", err)
+	}
+
+	leases := make([]entity.Lease, 0, len(leaseDTOs))
+	for _, l := range leaseDTOs {
+		leases = append(leases, entity.Lease{
+			Execution:      execDTOToEntity(&l.Execution),
+			Job:            dtoToEntity(&l.Job),
+			LeaseExpiresAt: leaseExpiresAt,
+		})
+	}
+	return leases, nil
+}
+
+// Heartbeat confirms that the worker is still running the execution and extends its lease.
+// It returns the new lease deadline and whether the worker should stop the execution
+// because it exceeded the job timeout.
+func (r *SchedulerCase) Heartbeat(ctx context.Context, execID string, workerID string) (int64, bool, error) {
+	if execID == "" || workerID == "" {
+		return 0, false, ErrInvalidJob
+	}
+
+	now := time.Now()
+	leaseExpiresAt := now.Add(r.leaseTTL).UnixMilli()
+	cancel, err := r.jobsRepo.Heartbeat(ctx, execID, workerID, now.UnixMilli(), leaseExpiresAt)
+	if err != nil {
+		return 0, false, mapExecutionError("heartbeat", err)
+	}
+	return leaseExpiresAt, cancel, nil
+}
+
+// Complete stores the result reported by the worker holding the execution.
+// A failed execution is retried according to the retry policy of the job.
+func (r *SchedulerCase) Complete(ctx context.Context, execID string, workerID string, result entity.ExecutionResult) error {
+	if execID == "" || workerID == "" {
+		return ErrInvalidJob
+	}
+
+	now := time.Now()
+	exec := &repo.ExecutionDTO{
+		ID:         execID,
+		WorkerID:   workerID,
+		Status:     string(repo.Completed),
+		FinishedAt: now.UnixMilli(),
+		Output:     result.Output,
+		Error:      result.Error,
+	}
+
+	// Задание нужно и успешному выполнению: по нему рассылаются уведомления
+	stored, err := r.jobsRepo.ReadExecution(ctx, execID)
+	if err != nil {
+		return mapExecutionError("complete", err)
+	}
+	job, err := r.jobsRepo.Read(ctx, stored.JobID)
+	if err != nil {
+		return mapExecutionError("complete", err)
+	}
+	exec.JobID = stored.JobID
+	exec.Attempt = stored.Attempt
+	exec.Trigger = stored.Trigger
+	exec.Payload = stored.Payload
+	exec.WorkflowRunID = stored.WorkflowRunID
+
+	var retry *repo.ExecutionDTO
+	var deadLetter *repo.DeadLetterDTO
+	if !result.Success {
+		retry, deadLetter = failExecution(retryPolicyToEntity(job.RetryPolicy), exec, repo.Failed, result.ErrorClass, now)
+	}
+
+	notifications := notificationsFor(notificationsToEntity(job.Notifications), exec, deadLetter, now)
+	if err := r.jobsRepo.FinishExecution(ctx, exec, retry, deadLetter, notifications); err != nil {
+		return mapExecutionError("complete", err)
+	}
+	return nil
+}
+
+func mapExecutionError(op string, err error) error {
+	switch {
+	case errors.Is(err, repo.ErrNotFound):
+		return ErrNotFound
+	case errors.Is(err, repo.ErrConflict):
+		return ErrConflict
+	}
+	return fmt.Errorf("%s error:%w", op, err)
+}
@@ -0,0 +1,311 @@
+package cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"scheduler/internal/entity"
+	"scheduler/internal/port/repo"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const workflowRunsBatchSize = 100
+
+// CreateWorkflow validates the workflow, creates a job for every node and returns the workflow ID.
+// Node jobs have no schedule, they run only as part of workflow runs and may overlap between runs.
+func (r *SchedulerCase) CreateWorkflow(ctx context.Context, workflow *entity.Workflow) (string, error) {
+	if err := validateWorkflow(workflow, r.execCommands); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	workflow.ID = uuid.NewString()
+	workflow.CreatedAt = now.UnixMilli()
+
+	dto := &repo.WorkflowDTO{
+		ID:        workflow.ID,
+		Name:      workflow.Name,
+		CreatedAt: workflow.CreatedAt,
+		Nodes:     make([]repo.WorkflowNodeDTO, 0, len(workflow.Nodes)),
+	}
+	jobs := make([]repo.JobDTO, 0, len(workflow.Nodes))
+	for i := range workflow.Nodes {
+		node := &workflow.Nodes[i]
+		node.Job.ID = uuid.NewString()
+
+		var timeout *int64
+		if node.Job.Timeout != "" {
+			d, _ := entity.ParseTimeout(node.Job.Timeout) // checked by validateWorkflow
+			ms := d.Milliseconds()
+			timeout = &ms
+		}
+		jobType, _ := entity.ParseJobType(string(node.Job.Type)) // checked by validateWorkflow
+		jobs = append(jobs, repo.JobDTO{
+			ID:                node.Job.ID,
+			Type:              string(jobType),
+			Status:            repo.Queued,
+			CreatedAt:         workflow.CreatedAt,
+			Payload:           node.Job.Payload,
+			Timezone:          time.UTC.String(),
+			RetryPolicy:       retryPolicyToDTO(node.Job.RetryPolicy),
+			Timeout:           timeout,
+			MisfirePolicy:     string(entity.MisfireFireOnceNow),
+			ConcurrencyPolicy: string(entity.ConcurrencyAllow),
+			WorkflowID:        &workflow.ID,
+		})
+		dto.Nodes = append(dto.Nodes, repo.WorkflowNodeDTO{
+			Name:      node.Name,
+			DependsOn: node.DependsOn,
+			JobID:     node.Job.ID,
+		})
+	}
+
+	if err := r.jobsRepo.CreateWorkflow(ctx, dto, jobs); err != nil {
+		return "", fmt.Errorf("create workflow error:%w", err)
+	}
+	return workflow.ID, nil
+}
+
+// GetWorkflow returns the workflow with the jobs of its nodes.
+func (r *SchedulerCase) GetWorkflow(ctx context.Context, workflowID string) (entity.Workflow, error) {
+	if workflowID == "" {
+		return entity.Workflow{}, ErrInvalidJob
+	}
+
+	dto, err := r.jobsRepo.ReadWorkflow(ctx, workflowID)
+	if err != nil {
+		return entity.Workflow{}, mapExecutionError("get workflow", err)
+	}
+
+	workflow := workflowDTOToEntity(dto)
+	for i := range workflow.Nodes {
+		job, err := r.jobsRepo.Read(ctx, workflow.Nodes[i].Job.ID)
+		if err != nil {
+			return entity.Workflow{}, fmt.Errorf("get workflow error:%w", err)
+		}
+		workflow.Nodes[i].Job = dtoToEntity(job)
+	}
+	return workflow, nil
+}
+
+// StartWorkflowRun starts a run of the workflow by queuing its nodes without dependencies
+// and returns the run ID. The engine starts the other nodes as their dependencies complete.
+func (r *SchedulerCase) StartWorkflowRun(ctx context.Context, workflowID string) (string, error) {
+	if workflowID == "" {
+		return "", ErrInvalidJob
+	}
+
+	dto, err := r.jobsRepo.ReadWorkflow(ctx, workflowID)
+	if err != nil {
+		return "", mapExecutionError("start workflow run", err)
+	}
+	workflow := workflowDTOToEntity(dto)
+
+	now := time.Now()
+	run := &repo.WorkflowRunDTO{
+		ID:         uuid.NewString(),
+		WorkflowID: workflowID,
+		Status:     repo.Running,
+		CreatedAt:  now.UnixMilli(),
+	}
+	_, ready, _ := workflow.Advance(nil)
+
+	if err := r.jobsRepo.StartWorkflowRun(ctx, run, nodeExecutions(run.ID, ready, now)); err != nil {
+		return "", fmt.Errorf("start workflow run error:%w", err)
+	}
+	return run.ID, nil
+}
+
+// GetWorkflowRun returns the run of the workflow with the status of every node.
+func (r *SchedulerCase) GetWorkflowRun(ctx context.Context, workflowID string, runID string) (entity.WorkflowRun, error) {
+	if workflowID == "" || runID == "" {
+		return entity.WorkflowRun{}, ErrInvalidJob
+	}
+
+	runDTO, err := r.jobsRepo.ReadWorkflowRun(ctx, runID)
+	if err != nil {
+		return entity.WorkflowRun{}, mapExecutionError("get workflow run", err)
+	}
+	if runDTO.WorkflowID != workflowID {
+		return entity.WorkflowRun{}, ErrNotFound
+	}
+	workflowDTO, err := r.jobsRepo.ReadWorkflow(ctx, workflowID)
+	if err != nil {
+		return entity.WorkflowRun{}, mapExecutionError("get workflow run", err)
+	}
+	execs, err := r.jobsRepo.ListWorkflowRunExecutions(ctx, runID)
+	if err != nil {
+		return entity.WorkflowRun{}, fmt.Errorf("get workflow run error:%w", err)
+	}
+
+	workflow := workflowDTOToEntity(workflowDTO)
+	latest := latestNodeExecutions(execs)
+	statuses, _, _ := workflow.Advance(nodeStatuses(workflow, latest))
+
+	run := entity.WorkflowRun{
+		ID:         runDTO.ID,
+		WorkflowID: runDTO.WorkflowID,
+		Status:     entity.Status(runDTO.Status),
+		CreatedAt:  runDTO.CreatedAt,
+		FinishedAt: runDTO.FinishedAt,
+		Nodes:      make([]entity.WorkflowRunNode, 0, len(workflow.Nodes)),
+	}
+	for _, n := range workflow.Nodes {
+		node := entity.WorkflowRunNode{
+			Name:   n.Name,
+			JobID:  n.Job.ID,
+			Status: statuses[n.Name],
+		}
+		if e, ok := latest[n.Job.ID]; ok {
+			exec := execDTOToEntity(&e)
+			node.Execution = &exec
+		}
+		run.Nodes = append(run.Nodes, node)
+	}
+	return run, nil
+}
+
+// advanceWorkflows starts the nodes whose dependencies have completed and finishes the runs with nothing left to do.
+func (e *Engine) advanceWorkflows(ctx context.Context, shards *repo.ShardsDTO, now time.Time) {
+	runs, err := e.jobsRepo.ListActiveWorkflowRuns(ctx, shards, workflowRunsBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Error("list workflow runs", zap.Error(err))
+		}
+		return
+	}
+
+	for i := range runs {
+		if err := e.advanceWorkflowRun(ctx, &runs[i], now); err != nil {
+			e.logger.Error("advance workflow run", zap.String("run_id", runs[i].ID), zap.Error(err))
+		}
+	}
+}
+
+func (e *Engine) advanceWorkflowRun(ctx context.Context, run *repo.WorkflowRunDTO, now time.Time) error {
+	workflowDTO, err := e.jobsRepo.ReadWorkflow(ctx, run.WorkflowID)
+	if err != nil {
+		return err
+	}
+	execs, err := e.jobsRepo.ListWorkflowRunExecutions(ctx, run.ID)
+	if err != nil {
+		return err
+	}
+
+	workflow := workflowDTOToEntity(workflowDTO)
+	_, ready, status := workflow.Advance(nodeStatuses(workflow, latestNodeExecutions(execs)))
+	if len(ready) == 0 && status == entity.Running {
+		return nil
+	}
+
+	run.Status = repo.Status(status)
+	if status != entity.Running {
+		run.FinishedAt = now.UnixMilli()
+		e.logger.Info("workflow run finished", zap.String("run_id", run.ID), zap.String("status", string(status)))
+	}
+
+	// ErrConflict means the run has been advanced by another engine meanwhile
+	err = e.jobsRepo.AdvanceWorkflowRun(ctx, run, nodeExecutions(run.ID, ready, now))
+	if err != nil && !errors.Is(err, repo.ErrConflict) {
+		return err
+	}
+	return nil
+}
+
+// validateWorkflow checks the graph of the workflow and the job templates of its nodes.
+func validateWorkflow(workflow *entity.Workflow, execCommands []string) error {
+	verr := &ValidationError{}
+	verr.Fields = append(verr.Fields, workflow.Validate()...)
+	for i, n := range workflow.Nodes {
+		prefix := fmt.Sprintf("nodes[%d].job.", i)
+		var fields []entity.FieldError
+		if n.Job.Timeout != "" {
+			var fieldErr *entity.FieldError
+			if _, err := entity.ParseTimeout(n.Job.Timeout); errors.As(err, &fieldErr) {
+				fields = append(fields, *fieldErr)
+			}
+		}
+		if n.Job.RetryPolicy != nil {
+			fields = append(fields, n.Job.RetryPolicy.Validate()...)
+		}
+		fields = append(fields, validateJobType(&n.Job, execCommands)...)
+		for _, f := range fields {
+			verr.add(prefix+f.Field, f.Reason)
+		}
+	}
+
+	if len(verr.Fields) > 0 {
+		return verr
+	}
+	return nil
+}
+
+// latestNodeExecutions returns the latest execution of every node job, i.e. the last retry of the node.
+// execs are expected in the order they were scheduled.
+func latestNodeExecutions(execs []repo.ExecutionDTO) map[string]repo.ExecutionDTO {
+	latest := make(map[string]repo.ExecutionDTO, len(execs))
+	for _, e := range execs {
+		latest[e.JobID] = e
+	}
+	return latest
+}
+
+// nodeStatuses maps the latest executions of node jobs to the statuses of the nodes which started.
+func nodeStatuses(workflow entity.Workflow, latest map[string]repo.ExecutionDTO) map[string]entity.NodeStatus {
+	statuses := make(map[string]entity.NodeStatus, len(latest))
+	for _, n := range workflow.Nodes {
+		e, ok := latest[n.Job.ID]
+		if !ok {
+			continue
+		}
+		switch repo.Status(e.Status) {
+		case repo.Queued:
+			statuses[n.Name] = entity.NodeQueued
+		case repo.Running:
+			statuses[n.Name] = entity.NodeRunning
+		case repo.Completed:
+			statuses[n.Name] = entity.NodeCompleted
+		default:
+			// A failure followed by a retry is superseded by the queued retry
+			statuses[n.Name] = entity.NodeFailed
+		}
+	}
+	return statuses
+}
+
+// nodeExecutions queues the first attempts of the nodes within the run.
+func nodeExecutions(runID string, nodes []entity.WorkflowNode, now time.Time) []repo.ExecutionDTO {
+	execs := make([]repo.ExecutionDTO, 0, len(nodes))
+	for _, n := range nodes {
+		execs = append(execs, repo.ExecutionDTO{
+			ID:            uuid.NewString(),
+			JobID:         n.Job.ID,
+			Status:        string(repo.Queued),
+			Attempt:       1,
+			Trigger:       entity.TriggerWorkflow,
+			ScheduledAt:   now.UnixMilli(),
+			WorkflowRunID: runID,
+		})
+	}
+	return execs
+}
+
+func workflowDTOToEntity(w *repo.WorkflowDTO) entity.Workflow {
+	workflow := entity.Workflow{
+		ID:        w.ID,
+		Name:      w.Name,
+		CreatedAt: w.CreatedAt,
+		Nodes:     make([]entity.WorkflowNode, 0, len(w.Nodes)),
+	}
+	for _, n := range w.Nodes {
+		workflow.Nodes = append(workflow.Nodes, entity.WorkflowNode{
+			Name:      n.Name,
+			DependsOn: n.DependsOn,
+			Job:       entity.Job{ID: n.JobID},
+		})
+	}
+	return workflow
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "fmt"
+
+// ConcurrencyPolicy tells what to do when a job is due while a previous run of it
+// is still in progress, like the concurrency policy of a Kubernetes CronJob.
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyAllow lets runs of the job overlap.
+	ConcurrencyAllow ConcurrencyPolicy = "allow"
+	// ConcurrencyForbid skips the new run while the previous one is in progress.
+	ConcurrencyForbid ConcurrencyPolicy = "forbid"
+	// ConcurrencyReplace cancels the run in progress and starts the new one.
+	ConcurrencyReplace ConcurrencyPolicy = "replace"
+	// ConcurrencyQueue starts the new run once the previous one finishes.
+	// A job which runs longer than its schedule piles up queued runs.
+	ConcurrencyQueue ConcurrencyPolicy = "queue"
+)
+
+// ParseConcurrencyPolicy parses a concurrency policy, empty value means ConcurrencyForbid.
+func ParseConcurrencyPolicy(value string) (ConcurrencyPolicy, error) {
+	switch p := ConcurrencyPolicy(value); p {
+	case "":
+		return ConcurrencyForbid, nil
+	case ConcurrencyAllow, ConcurrencyForbid, ConcurrencyReplace, ConcurrencyQueue:
+		return p, nil
+	}
+	return "", &FieldError{Field: "concurrencyPolicy", Reason: fmt.Sprintf("unknown policy %q", value)}
+}
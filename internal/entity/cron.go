@@ -0,0 +1,379 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxCronYears bounds the search for the next fire time of expressions
+// which fire rarely (e.g. on February 29) or never (e.g. on February 30).
+const MaxCronYears = 10
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+var (
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	weekdayNames = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+)
+
+// CronSchedule fires at the times matching a cron expression.
+//
+// Both the 5-field (minute hour day-of-month month day-of-week) and the
+// 6-field (with leading seconds) syntax are accepted, as well as the
+// @yearly, @monthly, @weekly, @daily and @hourly macros. Day-of-month
+// supports L (last day), L-n (n days before the last day), LW (last weekday)
+// and nW (weekday nearest to day n); day-of-week supports nL (last weekday n
+// of the month) and n#k (k-th weekday n of the month).
+//
+// As in classic cron, when both day fields are restricted a day matching
+// either of them fires. Expressions are evaluated in the wall clock of loc,
+// see FromWallClock for daylight saving time transitions.
+type CronSchedule struct {
+	expr     string
+	loc      *time.Location
+	seconds  bits
+	minutes  bits
+	hours    bits
+	months   bits
+	days     dayField
+	weekdays weekdayField
+}
+
+type bits uint64
+
+func (b bits) has(i int) bool {
+	return b&(1<<uint(i)) != 0
+}
+
+type dayField struct {
+	star        bool
+	set         bits
+	lastOffsets []int
+	lastWeekday bool
+	nearest     []int
+}
+
+type weekdayField struct {
+	star bool
+	set  bits
+	last bits
+	nth  []nthWeekday
+}
+
+type nthWeekday struct {
+	weekday int
+	n       int
+}
+
+// ParseCron parses a cron expression evaluated in loc.
+func ParseCron(expr string, loc *time.Location) (*CronSchedule, error) {
+	spec := strings.TrimSpace(expr)
+	if strings.HasPrefix(spec, "@") {
+		macro, ok := cronMacros[strings.ToLower(spec)]
+		if !ok {
+			return nil, cronError("unknown macro %q", spec)
+		}
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, cronError("expected 5 or 6 fields, got %d", len(fields))
+	}
+
+	s := &CronSchedule{expr: expr, loc: loc}
+	var err error
+	if s.seconds, err = parseField(fields[0], 0, 59, nil); err != nil {
+		return nil, cronError("seconds: %v", err)
+	}
+	if s.minutes, err = parseField(fields[1], 0, 59, nil); err != nil {
+		return nil, cronError("minutes: %v", err)
+	}
+	if s.hours, err = parseField(fields[2], 0, 23, nil); err != nil {
+		return nil, cronError("hours: %v", err)
+	}
+	if s.days, err = parseDays(fields[3]); err != nil {
+		return nil, cronError("day of month: %v", err)
+	}
+	if s.months, err = parseField(fields[4], 1, 12, monthNames); err != nil {
+		return nil, cronError("month: %v", err)
+	}
+	if s.weekdays, err = parseWeekdays(fields[5]); err != nil {
+		return nil, cronError("day of week: %v", err)
+	}
+	return s, nil
+}
+
+func (s *CronSchedule) String() string {
+	return s.expr
+}
+
+func (s *CronSchedule) Next(t time.Time) (time.Time, bool) {
+	loc := s.loc
+
+	// The search walks the wall clock of loc, represented in UTC to get plain calendar arithmetic
+	w := wallClock(t.In(loc)).Truncate(time.Second).Add(time.Second)
+	end := w.AddDate(MaxCronYears, 0, 0)
+	for w.Before(end) {
+		switch {
+		case !s.months.has(int(w.Month())):
+			w = time.Date(w.Year(), w.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(w):
+			w = time.Date(w.Year(), w.Month(), w.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !s.hours.has(w.Hour()):
+			w = w.Truncate(time.Hour).Add(time.Hour)
+		case !s.minutes.has(w.Minute()):
+			w = w.Truncate(time.Minute).Add(time.Minute)
+		case !s.seconds.has(w.Second()):
+			w = w.Add(time.Second)
+		default:
+			if at := FromWallClock(w, loc); at.After(t) {
+				return at, true
+			}
+			w = w.Add(time.Second)
+		}
+	}
+	return time.Time{}, false
+}
+
+func (s *CronSchedule) dayMatches(w time.Time) bool {
+	dom := s.days.matches(w)
+	dow := s.weekdays.matches(w)
+	if s.days.star || s.weekdays.star {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func (f dayField) matches(w time.Time) bool {
+	day := w.Day()
+	if f.set.has(day) {
+		return true
+	}
+
+	last := daysIn(w.Year(), w.Month())
+	for _, off := range f.lastOffsets {
+		if day == last-off {
+			return true
+		}
+	}
+	if f.lastWeekday && day == nearestWeekday(w.Year(), w.Month(), last) {
+		return true
+	}
+	for _, n := range f.nearest {
+		if n <= last && day == nearestWeekday(w.Year(), w.Month(), n) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f weekdayField) matches(w time.Time) bool {
+	weekday := int(w.Weekday())
+	if f.set.has(weekday) {
+		return true
+	}
+	if f.last.has(weekday) && w.Day()+7 > daysIn(w.Year(), w.Month()) {
+		return true
+	}
+	for _, nth := range f.nth {
+		if nth.weekday == weekday && (w.Day()-1)/7+1 == nth.n {
+			return true
+		}
+	}
+	return false
+}
+
+func parseDays(field string) (dayField, error) {
+	f := dayField{star: isStar(field)}
+	for _, item := range strings.Split(field, ",") {
+		switch {
+		case item == "L":
+			f.lastOffsets = append(f.lastOffsets, 0)
+		case strings.HasPrefix(item, "L-"):
+			off, err := parseNumber(item[2:], 0, 30, nil)
+			if err != nil {
+				return f, err
+			}
+			f.lastOffsets = append(f.lastOffsets, off)
+		case item == "LW":
+			f.lastWeekday = true
+		case strings.HasSuffix(item, "W"):
+			day, err := parseNumber(strings.TrimSuffix(item, "W"), 1, 31, nil)
+			if err != nil {
+				return f, err
+			}
+			f.nearest = append(f.nearest, day)
+		default:
+			set, err := parseItem(item, 1, 31, nil)
+			if err != nil {
+				return f, err
+			}
+			f.set |= set
+		}
+	}
+	return f, nil
+}
+
+func parseWeekdays(field string) (weekdayField, error) {
+	f := weekdayField{star: isStar(field)}
+	for _, item := range strings.Split(field, ",") {
+		switch {
+		case strings.Contains(item, "#"):
+			weekday, n, _ := strings.Cut(item, "#")
+			wd, err := parseWeekday(weekday)
+			if err != nil {
+				return f, err
+			}
+			k, err := parseNumber(n, 1, 5, nil)
+			if err != nil {
+				return f, err
+			}
+			f.nth = append(f.nth, nthWeekday{weekday: wd, n: k})
+		case len(item) > 1 && strings.HasSuffix(item, "L"):
+			wd, err := parseWeekday(strings.TrimSuffix(item, "L"))
+			if err != nil {
+				return f, err
+			}
+			f.last |= 1 << uint(wd)
+		default:
+			set, err := parseItem(item, 0, 7, weekdayNames)
+			if err != nil {
+				return f, err
+			}
+			// 7 is an alias for Sunday
+			if set.has(7) {
+				set = set&^(1<<7) | 1
+			}
+			f.set |= set
+		}
+	}
+	return f, nil
+}
+
+func parseWeekday(s string) (int, error) {
+	wd, err := parseNumber(s, 0, 7, weekdayNames)
+	return wd % 7, err
+}
+
+func parseField(field string, min, max int, names map[string]int) (bits, error) {
+	var set bits
+	for _, item := range strings.Split(field, ",") {
+		b, err := parseItem(item, min, max, names)
+		if err != nil {
+			return 0, err
+		}
+		set |= b
+	}
+	return set, nil
+}
+
+// parseItem parses one list item: *, ?, a value, a range, optionally with a /step.
+func parseItem(item string, min, max int, names map[string]int) (bits, error) {
+	rng, stepStr, hasStep := strings.Cut(item, "/")
+	step := 1
+	if hasStep {
+		var err error
+		if step, err = parseNumber(stepStr, 1, max, nil); err != nil {
+			return 0, err
+		}
+	}
+
+	var lo, hi int
+	switch {
+	case rng == "*" || rng == "?":
+		lo, hi = min, max
+	case strings.Contains(rng, "-"):
+		from, to, _ := strings.Cut(rng, "-")
+		var err error
+		if lo, err = parseNumber(from, min, max, names); err != nil {
+			return 0, err
+		}
+		if hi, err = parseNumber(to, min, max, names); err != nil {
+			return 0, err
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("range %q is reversed", rng)
+		}
+	default:
+		var err error
+		if lo, err = parseNumber(rng, min, max, names); err != nil {
+			return 0, err
+		}
+		hi = lo
+		// "5/15" means every 15 starting from 5
+		if hasStep {
+			hi = max
+		}
+	}
+
+	var set bits
+	for i := lo; i <= hi; i += step {
+		set |= 1 << uint(i)
+	}
+	return set, nil
+}
+
+func parseNumber(s string, min, max int, names map[string]int) (int, error) {
+	if n, ok := names[strings.ToUpper(s)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", n, min, max)
+	}
+	return n, nil
+}
+
+func isStar(field string) bool {
+	return strings.HasPrefix(field, "*") || strings.HasPrefix(field, "?")
+}
+
+func cronError(format string, args ...any) error {
+	return &FieldError{Field: "cron", Reason: fmt.Sprintf(format, args...)}
+}
+
+// nearestWeekday returns the Monday-Friday day of the month closest to day
+// without leaving the month.
+func nearestWeekday(year int, month time.Month, day int) int {
+	last := daysIn(year, month)
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == last {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
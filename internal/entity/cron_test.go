@@ -0,0 +1,101 @@
+package entity_test
+
+import (
+	"scheduler/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestCronNext(t *testing.T) {
+	// 2026-01-01 is a Thursday
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(value string) time.Time {
+		tm, err := time.Parse("2006-01-02 15:04", value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+
+	for _, tc := range []struct {
+		name string
+		expr string
+		want []string
+	}{
+		{"step", "*/15 * * * *", []string{"2026-01-01 00:15", "2026-01-01 00:30", "2026-01-01 00:45", "2026-01-01 01:00"}},
+		{"step from a value", "5/20 * * * *", []string{"2026-01-01 00:05", "2026-01-01 00:25", "2026-01-01 00:45", "2026-01-01 01:05"}},
+		{"seconds field and weekday range", "0 30 9 * * MON-FRI", []string{"2026-01-01 09:30", "2026-01-02 09:30", "2026-01-05 09:30"}},
+		{"names", "0 12 * JAN,MAR SUN", []string{"2026-01-04 12:00", "2026-01-11 12:00"}},
+		{"sunday as 7", "0 0 * * 7", []string{"2026-01-04 00:00", "2026-01-11 00:00"}},
+		{"hourly", "@hourly", []string{"2026-01-01 01:00", "2026-01-01 02:00"}},
+		{"daily", "@daily", []string{"2026-01-02 00:00", "2026-01-03 00:00"}},
+		{"weekly", "@weekly", []string{"2026-01-04 00:00", "2026-01-11 00:00"}},
+		{"monthly", "@monthly", []string{"2026-02-01 00:00", "2026-03-01 00:00"}},
+		{"yearly", "@yearly", []string{"2027-01-01 00:00", "2028-01-01 00:00"}},
+		{"last day", "0 0 L * *", []string{"2026-01-31 00:00", "2026-02-28 00:00", "2026-03-31 00:00"}},
+		{"days before the last", "0 0 L-2 * *", []string{"2026-01-29 00:00", "2026-02-26 00:00", "2026-03-29 00:00"}},
+		// January 31 and February 28, 2026 are Saturdays
+		{"last weekday", "0 0 LW * *", []string{"2026-01-30 00:00", "2026-02-27 00:00", "2026-03-31 00:00"}},
+		// February 15 and March 15, 2026 are Sundays
+		{"nearest weekday", "0 0 15W * *", []string{"2026-01-15 00:00", "2026-02-16 00:00", "2026-03-16 00:00"}},
+		// The nearest weekday stays within the month: February 1 is a Sunday, August 1 a Saturday
+		{"nearest weekday of the first", "0 0 1W 2,8 *", []string{"2026-02-02 00:00", "2026-08-03 00:00"}},
+		{"last friday", "0 0 * * 5L", []string{"2026-01-30 00:00", "2026-02-27 00:00", "2026-03-27 00:00"}},
+		{"second monday", "0 0 * * 1#2", []string{"2026-01-12 00:00", "2026-02-09 00:00", "2026-03-09 00:00"}},
+		// Both day fields are restricted: the 13th or a Friday fires
+		{"day of month or of week", "0 0 13 * 5", []string{"2026-01-02 00:00", "2026-01-09 00:00", "2026-01-13 00:00", "2026-01-16 00:00"}},
+		{"day of month and any weekday", "0 0 13 * *", []string{"2026-01-13 00:00", "2026-02-13 00:00"}},
+		{"leap day", "0 0 29 2 *", []string{"2028-02-29 00:00", "2032-02-29 00:00"}},
+	} {
+		s, err := entity.ParseCron(tc.expr, time.UTC)
+		if err != nil {
+			t.Errorf("%s: parse %q: %v", tc.name, tc.expr, err)
+			continue
+		}
+		next := from
+		for _, want := range tc.want {
+			var ok bool
+			if next, ok = s.Next(next); !ok || !next.Equal(at(want)) {
+				t.Errorf("%s: %q next = %v, %v; want %s", tc.name, tc.expr, next, ok, want)
+				break
+			}
+		}
+	}
+}
+
+func TestCronNeverFires(t *testing.T) {
+	s, err := entity.ParseCron("0 0 30 2 *", time.UTC)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if next, ok := s.Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("February 30 fires at %v", next)
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"@fortnightly",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * 32 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"* * * FOO *",
+		"10-5 * * * *",
+		"*/0 * * * *",
+		"* * L-31 * *",
+		"* * 32W * *",
+		"* * * * 1#6",
+		"* * * * 8L",
+		"x * * * *",
+	} {
+		if _, err := entity.ParseCron(expr, time.UTC); err == nil {
+			t.Errorf("%q parsed without an error", expr)
+		}
+	}
+}
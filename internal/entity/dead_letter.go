@@ -0,0 +1,12 @@
+package entity
+
+// DeadLetter is a run of a job which failed after exhausting its retry policy.
+type DeadLetter struct {
+	Attempts    int    `json:"attempts"`
+	CreatedAt   int64  `json:"createdAt"`
+	ExecutionId string `json:"executionId"`
+	Id          string `json:"id"`
+	JobId       string `json:"jobId"`
+	Output      string `json:"output,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ExecRequest is the payload of a JobTypeExec job:
+//
+//	{"command": "/usr/local/bin/backup", "args": ["--full"], "env": {"TARGET": "s3"},
+//	 "dir": "/var/backups", "timeout": "10m", "maxOutput": 65536}
+//
+// The command runs with env as its whole environment, the scheduler environment is not inherited.
+// Timeout and maxOutput may only lower the limits configured for the scheduler.
+type ExecRequest struct {
+	Command   string            `json:"command"`
+	Args      []string          `json:"args,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	Dir       string            `json:"dir,omitempty"`
+	Timeout   string            `json:"timeout,omitempty"`
+	MaxOutput int               `json:"maxOutput,omitempty"`
+}
+
+// ParseExecRequest decodes and checks the payload of an exec job.
+// Only the commands listed in allowed may run, nil allows none.
+func ParseExecRequest(payload map[string]any, allowed []string) (ExecRequest, []FieldError) {
+	var req ExecRequest
+	data, err := json.Marshal(payload)
+	if err == nil {
+		err = json.Unmarshal(data, &req)
+	}
+	if err != nil {
+		return req, []FieldError{{Field: "payload", Reason: "invalid exec request: " + err.Error()}}
+	}
+
+	var errs []FieldError
+	switch {
+	case len(allowed) == 0:
+		errs = append(errs, FieldError{Field: "type", Reason: "exec jobs are disabled"})
+	case req.Command == "":
+		errs = append(errs, FieldError{Field: "payload.command", Reason: "is required"})
+	case !slices.Contains(allowed, req.Command):
+		errs = append(errs, FieldError{Field: "payload.command", Reason: fmt.Sprintf("command %q is not allowed", req.Command)})
+	}
+	if req.Timeout != "" {
+		if d, err := time.ParseDuration(req.Timeout); err != nil || d <= 0 {
+			errs = append(errs, FieldError{Field: "payload.timeout", Reason: "must be a positive Go duration"})
+		}
+	}
+	if req.MaxOutput < 0 {
+		errs = append(errs, FieldError{Field: "payload.maxOutput", Reason: "must not be negative"})
+	}
+	return req, errs
+}
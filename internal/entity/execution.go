@@ -1,10 +1,58 @@
 package entity
 
+const (
+	// TriggerSchedule marks executions fired by the job schedule.
+	TriggerSchedule = "schedule"
+	// TriggerManual marks executions started on request, such as POST /jobs/{job_id}/trigger.
+	TriggerManual = "manual"
+	// TriggerWorkflow marks executions of workflow nodes started by a workflow run.
+	TriggerWorkflow = "workflow"
+)
+
 type Execution struct {
+	Attempt    int    `json:"attempt,omitempty"`
 	FinishedAt int64  `json:"finishedAt,omitempty"`
 	Id         string `json:"id,omitempty"`
 	JobId      string `json:"jobId,omitempty"`
+	Output     string `json:"output,omitempty"`
+	Error      string `json:"error,omitempty"`
+	Reason     string `json:"reason,omitempty"`
 	StartedAt  int64  `json:"startedAt,omitempty"`
 	Status     string `json:"status,omitempty"`
+	Trigger    string `json:"trigger,omitempty"`
 	WorkerId   string `json:"workerId,omitempty"`
+	// WorkflowRunId is set for executions of workflow nodes.
+	WorkflowRunId string `json:"workflowRunId,omitempty"`
+}
+
+// Lease is an execution handed out to an external worker together with its job.
+type Lease struct {
+	Execution      Execution
+	Job            Job
+	LeaseExpiresAt int64
+}
+
+// ExecutionResult is the outcome of an execution reported by the worker which ran it.
+type ExecutionResult struct {
+	Success bool
+	Output  string
+	// Error describes the failure for humans, unlike ErrorClass.
+	Error string
+	// ErrorClass of a failed execution is matched against RetryPolicy.RetryableErrors.
+	ErrorClass string
+}
+
+// Streams of execution logs.
+const (
+	LogStdout = "stdout"
+	LogStderr = "stderr"
+)
+
+// ExecutionLog is a chunk of the output of an execution appended by its worker.
+// Seq grows with every chunk of the execution.
+type ExecutionLog struct {
+	Seq       int64  `json:"seq"`
+	Stream    string `json:"stream"`
+	Data      string `json:"data"`
+	CreatedAt int64  `json:"createdAt"`
 }
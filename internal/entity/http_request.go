@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// HTTPRequest is the payload of a JobTypeHTTP job:
+//
+//	{"method": "POST", "url": "https://example.com/hook", "headers": {"X-Token": "..."},
+//	 "body": {"any": "json"}, "expectedStatus": [200, 202]}
+//
+// A string body is sent as is, any other body is sent as JSON.
+// Without expectedStatus any 2xx status counts as success.
+type HTTPRequest struct {
+	Method         string            `json:"method,omitempty"`
+	URL            string            `json:"url"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	Body           any               `json:"body,omitempty"`
+	ExpectedStatus []int             `json:"expectedStatus,omitempty"`
+}
+
+// ParseHTTPRequest decodes and checks the payload of an HTTP job, the method defaults to GET.
+func ParseHTTPRequest(payload map[string]any) (HTTPRequest, []FieldError) {
+	var req HTTPRequest
+	data, err := json.Marshal(payload)
+	if err == nil {
+		err = json.Unmarshal(data, &req)
+	}
+	if err != nil {
+		return req, []FieldError{{Field: "payload", Reason: "invalid HTTP request: " + err.Error()}}
+	}
+
+	var errs []FieldError
+	if req.Method == "" {
+		req.Method = http.MethodGet
+	}
+	req.Method = strings.ToUpper(req.Method)
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions:
+	default:
+		errs = append(errs, FieldError{Field: "payload.method", Reason: fmt.Sprintf("unsupported method %q", req.Method)})
+	}
+
+	if req.URL == "" {
+		errs = append(errs, FieldError{Field: "payload.url", Reason: "is required"})
+	} else if u, err := url.Parse(req.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, FieldError{Field: "payload.url", Reason: "must be an absolute http or https URL"})
+	}
+
+	for i, status := range req.ExpectedStatus {
+		if status < 100 || status > 599 {
+			errs = append(errs, FieldError{Field: fmt.Sprintf("payload.expectedStatus[%d]", i), Reason: "must be between 100 and 599"})
+		}
+	}
+	return req, errs
+}
+
+// Expects tells whether the response status means success.
+func (r HTTPRequest) Expects(status int) bool {
+	if len(r.ExpectedStatus) == 0 {
+		return status >= 200 && status < 300
+	}
+	return slices.Contains(r.ExpectedStatus, status)
+}
@@ -3,18 +3,36 @@ package entity
 const (
 	Completed Status = "completed"
 	Failed    Status = "failed"
+	Paused    Status = "paused"
 	Queued    Status = "queued"
 	Running   Status = "running"
+	TimedOut  Status = "timed_out"
 )
 
 type Status string
 
 type Job struct {
+	// Type tells who runs the job, external workers by default.
+	Type           JobType                `json:"type,omitempty"`
 	CreatedAt      int64                  `json:"createdAt"`
+	Cron           string                 `json:"cron,omitempty"`
 	ID             string                 `json:"id"`
 	Interval       string                 `json:"interval,omitempty"`
 	LastFinishedAt int64                  `json:"lastFinishedAt"`
+	NextRunAt      int64                  `json:"nextRunAt,omitempty"`
 	Once           string                 `json:"once,omitempty"`
 	Payload        map[string]interface{} `json:"payload"`
+	RetryPolicy    *RetryPolicy           `json:"retryPolicy,omitempty"`
 	Status         Status                 `json:"status"`
+	Timezone       string                 `json:"timezone,omitempty"`
+	Timeout        string                 `json:"timeout,omitempty"`
+	MisfirePolicy  MisfirePolicy          `json:"misfirePolicy,omitempty"`
+	// ConcurrencyPolicy applies to scheduled and manual runs alike.
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// WorkflowID is set for the jobs of workflow nodes, which have no schedule.
+	WorkflowID string `json:"workflowId,omitempty"`
+	// Notifications are the webhooks called on the outcomes of the executions.
+	Notifications []Notification `json:"notifications,omitempty"`
+	// Labels group jobs, e.g. by team, service and environment, for label selectors.
+	Labels map[string]string `json:"labels,omitempty"`
 }
@@ -0,0 +1,27 @@
+package entity
+
+import "fmt"
+
+// JobType tells who runs the job.
+type JobType string
+
+const (
+	// JobTypeWorker jobs are leased and run by external workers.
+	JobTypeWorker JobType = "worker"
+	// JobTypeHTTP jobs send the HTTP request described by their payload, the engine runs them in-process.
+	JobTypeHTTP JobType = "http"
+	// JobTypeExec jobs run the command described by their payload on the scheduler host.
+	// They are disabled unless the command is in the allowlist of the scheduler.
+	JobTypeExec JobType = "exec"
+)
+
+// ParseJobType parses a job type, empty value means JobTypeWorker.
+func ParseJobType(value string) (JobType, error) {
+	switch t := JobType(value); t {
+	case "":
+		return JobTypeWorker, nil
+	case JobTypeWorker, JobTypeHTTP, JobTypeExec:
+		return t, nil
+	}
+	return "", &FieldError{Field: "type", Reason: fmt.Sprintf("unknown type %q", value)}
+}
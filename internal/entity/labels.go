@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	maxLabels         = 64
+	maxLabelNameLen   = 63
+	maxLabelPrefixLen = 253
+)
+
+var (
+	labelNameRe   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	labelPrefixRe = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// CheckLabelKey checks a label key the way Kubernetes does: a name of up to 63 characters,
+// optionally prefixed with a DNS subdomain and a slash, such as example.com/team.
+func CheckLabelKey(key string) error {
+	name := key
+	if prefix, rest, ok := strings.Cut(key, "/"); ok {
+		if len(prefix) > maxLabelPrefixLen || !labelPrefixRe.MatchString(prefix) {
+			return fmt.Errorf("invalid prefix of label key %q", key)
+		}
+		name = rest
+	}
+	if len(name) > maxLabelNameLen || !labelNameRe.MatchString(name) {
+		return fmt.Errorf("invalid label key %q: up to %d letters, digits, '-', '_' or '.', starting and ending with a letter or digit", key, maxLabelNameLen)
+	}
+	return nil
+}
+
+// CheckLabelValue checks a label value, which is empty or looks like the name of a key.
+func CheckLabelValue(value string) error {
+	if value != "" && (len(value) > maxLabelNameLen || !labelNameRe.MatchString(value)) {
+		return fmt.Errorf("invalid label value %q: up to %d letters, digits, '-', '_' or '.', starting and ending with a letter or digit", value, maxLabelNameLen)
+	}
+	return nil
+}
+
+// ValidateLabels returns an error for every invalid label of a job.
+func ValidateLabels(labels map[string]string) []FieldError {
+	var errs []FieldError
+	if len(labels) > maxLabels {
+		errs = append(errs, FieldError{Field: "labels", Reason: fmt.Sprintf("at most %d labels are allowed", maxLabels)})
+	}
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	// Sorted keys report the errors in the same order every time
+	slices.Sort(keys)
+	for _, key := range keys {
+		if err := CheckLabelKey(key); err != nil {
+			errs = append(errs, FieldError{Field: "labels", Reason: err.Error()})
+		}
+		if err := CheckLabelValue(labels[key]); err != nil {
+			errs = append(errs, FieldError{Field: "labels." + key, Reason: err.Error()})
+		}
+	}
+	return errs
+}
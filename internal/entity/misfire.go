@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MisfirePolicy tells what to do with fire times a job missed, e.g. while the
+// scheduler was down, the job was paused or its previous run took too long.
+type MisfirePolicy string
+
+const (
+	// MisfireFireOnceNow fires once right away in place of all the missed fire times.
+	MisfireFireOnceNow MisfirePolicy = "fire_once_now"
+	// MisfireFireAll fires once for every missed fire time, one run after another.
+	MisfireFireAll MisfirePolicy = "fire_all"
+	// MisfireSkip drops the missed fire times and waits for the next regular one.
+	MisfireSkip MisfirePolicy = "skip"
+
+	// misfireFireIfWithin prefixes policies which fire once now when the job is late
+	// by at most the given duration and skip otherwise, e.g. fire_if_within:10m.
+	misfireFireIfWithin = "fire_if_within:"
+
+	// maxMissedFires bounds the missed fire times reported by a plan,
+	// so that a long downtime of a frequent job does not flood the history.
+	maxMissedFires = 100
+)
+
+// ParseMisfirePolicy parses a misfire policy, empty value means MisfireFireOnceNow.
+func ParseMisfirePolicy(value string) (MisfirePolicy, error) {
+	switch p := MisfirePolicy(value); p {
+	case "":
+		return MisfireFireOnceNow, nil
+	case MisfireFireOnceNow, MisfireFireAll, MisfireSkip:
+		return p, nil
+	}
+	if within, ok := strings.CutPrefix(value, misfireFireIfWithin); ok {
+		if d, err := time.ParseDuration(within); err == nil && d > 0 {
+			return MisfirePolicy(value), nil
+		}
+		return "", &FieldError{Field: "misfirePolicy", Reason: fmt.Sprintf("%q is not a positive duration", within)}
+	}
+	return "", &FieldError{Field: "misfirePolicy", Reason: fmt.Sprintf("unknown policy %q", value)}
+}
+
+// FirePlan is the decision about a due job.
+type FirePlan struct {
+	// Fire tells whether to start a run now.
+	Fire bool
+	// Skipped lists the fire times which are dropped, at most maxMissedFires of them.
+	Skipped []time.Time
+	// Next is the fire time to wait for after this one, zero when the job will not fire anymore.
+	Next time.Time
+}
+
+// Plan decides about a job which was due at due and is picked up at now.
+// late tells whether the delay is long enough for due to count as missed.
+func (p MisfirePolicy) Plan(schedule Schedule, due, now time.Time, late bool) FirePlan {
+	policy := p
+	if within, ok := strings.CutPrefix(string(p), misfireFireIfWithin); ok {
+		policy = MisfireSkip
+		if d, err := time.ParseDuration(within); err == nil && now.Sub(due) <= d {
+			policy = MisfireFireOnceNow
+		}
+	}
+
+	if !late || policy == MisfireFireAll {
+		// The following fire times, if missed as well, are dealt with one at a time
+		next, _ := schedule.Next(due)
+		return FirePlan{Fire: true, Next: next}
+	}
+
+	missed := missedFires(schedule, due, now)
+	next, _ := schedule.Next(now)
+	if policy == MisfireSkip {
+		return FirePlan{Skipped: missed, Next: next}
+	}
+	// The run started now stands for the latest missed fire time
+	return FirePlan{Fire: true, Skipped: missed[:len(missed)-1], Next: next}
+}
+
+// missedFires returns due and the fire times following it up to now.
+func missedFires(schedule Schedule, due, now time.Time) []time.Time {
+	missed := []time.Time{due}
+	for t := due; len(missed) < maxMissedFires; {
+		next, ok := schedule.Next(t)
+		if !ok || next.After(now) {
+			break
+		}
+		missed = append(missed, next)
+		t = next
+	}
+	return missed
+}
@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// NotificationEvent is an outcome of an execution which webhooks may subscribe to.
+type NotificationEvent string
+
+const (
+	EventSucceeded NotificationEvent = "succeeded"
+	// EventFailed is sent for every failed attempt, retried or not.
+	EventFailed NotificationEvent = "failed"
+	// EventTimedOut is sent for every attempt which exceeded the job timeout.
+	EventTimedOut NotificationEvent = "timed_out"
+	// EventDeadLettered is sent once the retry policy of the run is exhausted.
+	EventDeadLettered NotificationEvent = "dead_lettered"
+)
+
+// Delivery statuses of notifications.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryFailed    = "failed"
+)
+
+// Notification is a webhook of a job called on the events it subscribes to.
+// The request body is signed with HMAC-SHA256 keyed by Secret.
+type Notification struct {
+	URL    string              `json:"url"`
+	Secret string              `json:"secret,omitempty"`
+	Events []NotificationEvent `json:"events"`
+}
+
+// Validate returns an error for every invalid field of the i-th notification of a job.
+func (n *Notification) Validate(i int) []FieldError {
+	prefix := fmt.Sprintf("notifications[%d].", i)
+	var errs []FieldError
+	if u, err := url.Parse(n.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, FieldError{Field: prefix + "url", Reason: "must be an absolute http or https URL"})
+	}
+	if n.Secret == "" {
+		errs = append(errs, FieldError{Field: prefix + "secret", Reason: "is required to sign the body"})
+	}
+	if len(n.Events) == 0 {
+		errs = append(errs, FieldError{Field: prefix + "events", Reason: "at least one event is required"})
+	}
+	for j, e := range n.Events {
+		switch e {
+		case EventSucceeded, EventFailed, EventTimedOut, EventDeadLettered:
+		default:
+			errs = append(errs, FieldError{Field: fmt.Sprintf("%sevents[%d]", prefix, j), Reason: fmt.Sprintf("unknown event %q", e)})
+		}
+	}
+	return errs
+}
+
+// Subscribed tells whether the webhook is called on the event.
+func (n *Notification) Subscribed(event NotificationEvent) bool {
+	return slices.Contains(n.Events, event)
+}
+
+// NotificationDelivery is a call of a webhook for an event, retried until the webhook
+// accepts it or the attempts run out.
+type NotificationDelivery struct {
+	ID             string            `json:"id"`
+	JobID          string            `json:"jobId"`
+	ExecutionID    string            `json:"executionId"`
+	Event          NotificationEvent `json:"event"`
+	URL            string            `json:"url"`
+	Status         string            `json:"status"`
+	Attempts       int               `json:"attempts"`
+	LastStatusCode int               `json:"lastStatusCode,omitempty"`
+	LastError      string            `json:"lastError,omitempty"`
+	CreatedAt      int64             `json:"createdAt"`
+	NextAttemptAt  int64             `json:"nextAttemptAt,omitempty"`
+	DeliveredAt    int64             `json:"deliveredAt,omitempty"`
+}
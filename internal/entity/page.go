@@ -0,0 +1,66 @@
+package entity
+
+// Fields jobs are sorted by.
+const (
+	JobSortCreatedAt      = "createdAt"
+	JobSortLastFinishedAt = "lastFinishedAt"
+	JobSortNextRunAt      = "nextRunAt"
+)
+
+// Orders of sorted lists.
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// Kinds of job schedules, each job has at most one of them.
+const (
+	ScheduleOnce     = "once"
+	ScheduleInterval = "interval"
+	ScheduleCron     = "cron"
+)
+
+// JobsQuery selects a page of jobs. Empty fields don't filter and take the defaults.
+type JobsQuery struct {
+	Status   *string
+	Schedule string
+	// CreatedAfter is inclusive, CreatedBefore is exclusive, both in Unix milliseconds.
+	CreatedAfter  *int64
+	CreatedBefore *int64
+	// Selector is a Kubernetes-style label selector, such as team=billing,env!=prod,tier in (a,b).
+	Selector string
+	Sort     string
+	Order    string
+	Limit    int
+	// Cursor is NextCursor of the previous page.
+	Cursor string
+}
+
+// JobsPage is a page of jobs, NextCursor is empty on the last page.
+type JobsPage struct {
+	Jobs       []Job
+	NextCursor string
+}
+
+// ExecutionSortStartedAt is the only field executions are sorted by.
+const ExecutionSortStartedAt = "startedAt"
+
+// ExecutionsQuery selects a page of the executions of a job, sorted by the start time.
+// Empty fields don't filter and take the defaults.
+type ExecutionsQuery struct {
+	WorkerID *string
+	Status   *string
+	// StartedAfter is inclusive, StartedBefore is exclusive, both in Unix milliseconds.
+	StartedAfter  *int64
+	StartedBefore *int64
+	Order         string
+	Limit         int
+	// Cursor is NextCursor of the previous page.
+	Cursor string
+}
+
+// ExecutionsPage is a page of executions, NextCursor is empty on the last page.
+type ExecutionsPage struct {
+	Executions []Execution
+	NextCursor string
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	defaultRetryInitialDelay = time.Second
+	defaultRetryMultiplier   = 2
+	// defaultRetryMaxDelay caps the backoff of policies without MaxDelay.
+	defaultRetryMaxDelay = 24 * time.Hour
+)
+
+// RetryPolicy describes how failed executions of a job are retried.
+//
+// The n-th retry waits InitialDelay * Multiplier^(n-1), at most MaxDelay.
+// Jitter in [0, 1] spreads the delay uniformly by up to that fraction in both
+// directions, so that jobs failed together are not retried together.
+type RetryPolicy struct {
+	// MaxAttempts counts all executions of a run, the first one included.
+	MaxAttempts  int     `json:"maxAttempts"`
+	InitialDelay string  `json:"initialDelay,omitempty"`
+	Multiplier   float64 `json:"multiplier,omitempty"`
+	MaxDelay     string  `json:"maxDelay,omitempty"`
+	Jitter       float64 `json:"jitter,omitempty"`
+	// RetryableErrors lists the error classes worth retrying, any error is retried when empty.
+	RetryableErrors []string `json:"retryableErrors,omitempty"`
+}
+
+// Validate returns an error for every invalid field of the policy.
+func (p *RetryPolicy) Validate() []FieldError {
+	var errs []FieldError
+	if p.MaxAttempts < 1 {
+		errs = append(errs, FieldError{Field: "retryPolicy.maxAttempts", Reason: "must be at least 1"})
+	}
+	if p.InitialDelay != "" {
+		if d, err := time.ParseDuration(p.InitialDelay); err != nil || d < 0 {
+			errs = append(errs, FieldError{Field: "retryPolicy.initialDelay", Reason: fmt.Sprintf("%q is not a non-negative duration", p.InitialDelay)})
+		}
+	}
+	if p.MaxDelay != "" {
+		if d, err := time.ParseDuration(p.MaxDelay); err != nil || d <= 0 {
+			errs = append(errs, FieldError{Field: "retryPolicy.maxDelay", Reason: fmt.Sprintf("%q is not a positive duration", p.MaxDelay)})
+		}
+	}
+	if p.Multiplier != 0 && p.Multiplier < 1 {
+		errs = append(errs, FieldError{Field: "retryPolicy.multiplier", Reason: "must be at least 1"})
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		errs = append(errs, FieldError{Field: "retryPolicy.jitter", Reason: "must be between 0 and 1"})
+	}
+	return errs
+}
+
+// CanRetry reports whether an execution which failed on the given attempt
+// with errorClass is followed by another attempt.
+func (p *RetryPolicy) CanRetry(attempt int, errorClass string) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	return len(p.RetryableErrors) == 0 || slices.Contains(p.RetryableErrors, errorClass)
+}
+
+// Delay returns how long to wait before the attempt following the given one.
+// random is a number in [0, 1) used for the jitter.
+func (p *RetryPolicy) Delay(attempt int, random float64) time.Duration {
+	initial := defaultRetryInitialDelay
+	if d, err := time.ParseDuration(p.InitialDelay); err == nil {
+		initial = d
+	}
+	maxDelay := defaultRetryMaxDelay
+	if d, err := time.ParseDuration(p.MaxDelay); err == nil {
+		maxDelay = d
+	}
+	multiplier := p.Multiplier
+	if multiplier == 0 {
+		multiplier = defaultRetryMultiplier
+	}
+
+	// Considered in float64 to survive overflow of large exponents
+	delay := math.Min(float64(initial)*math.Pow(multiplier, float64(attempt-1)), float64(maxDelay))
+	delay *= 1 + p.Jitter*(2*random-1)
+	return time.Duration(math.Min(delay, float64(maxDelay)))
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// FieldError describes an invalid field of a job definition.
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%v: %s: %s", ErrInvalidSchedule, e.Field, e.Reason)
+}
+
+func (e *FieldError) Unwrap() error {
+	return ErrInvalidSchedule
+}
+
+// Schedule computes fire times of a job.
+type Schedule interface {
+	// Next returns the first fire time strictly after t.
+	// ok is false when the schedule will not fire anymore.
+	Next(t time.Time) (next time.Time, ok bool)
+}
+
+// OnceSchedule fires a single time at At.
+type OnceSchedule struct {
+	At time.Time
+}
+
+func (s OnceSchedule) Next(t time.Time) (time.Time, bool) {
+	if s.At.After(t) {
+		return s.At, true
+	}
+	return time.Time{}, false
+}
+
+// IntervalSchedule fires every Every starting from the previous fire time.
+// Every is a duration of absolute time, so it is not affected by time zones.
+type IntervalSchedule struct {
+	Every time.Duration
+}
+
+func (s IntervalSchedule) Next(t time.Time) (time.Time, bool) {
+	return t.Add(s.Every), true
+}
+
+// Schedule builds the schedule described by the job's Once, Interval or Cron field.
+// Once and Cron are interpreted in the job's Timezone.
+func (j *Job) Schedule() (Schedule, error) {
+	loc, err := LoadTimezone(j.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case j.Once != "":
+		at, err := ParseOnce(j.Once, loc)
+		if err != nil {
+			return nil, err
+		}
+		return OnceSchedule{At: at}, nil
+	case j.Interval != "":
+		every, err := ParseInterval(j.Interval)
+		if err != nil {
+			return nil, err
+		}
+		return IntervalSchedule{Every: every}, nil
+	case j.Cron != "":
+		return ParseCron(j.Cron, loc)
+	}
+	return nil, fmt.Errorf("%w: once, interval or cron is required", ErrInvalidSchedule)
+}
+
+// ParseTimeout parses the maximum runtime of an execution, a positive Go duration.
+func ParseTimeout(value string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, &FieldError{Field: "timeout", Reason: fmt.Sprintf("%q is not a duration", value)}
+	}
+	if timeout <= 0 {
+		return 0, &FieldError{Field: "timeout", Reason: "must be positive"}
+	}
+	return timeout, nil
+}
+
+// ParseInterval parses a positive Go duration such as 90s or 1h30m.
+func ParseInterval(value string) (time.Duration, error) {
+	every, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, &FieldError{Field: "interval", Reason: fmt.Sprintf("%q is not a duration", value)}
+	}
+	if every <= 0 {
+		return 0, &FieldError{Field: "interval", Reason: "must be positive"}
+	}
+	return every, nil
+}
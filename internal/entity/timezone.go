@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// localLayouts are accepted for once schedules given as a wall clock time of the job time zone.
+var localLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+// LoadTimezone returns the location for an IANA time zone name; empty name means UTC.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if name == "Local" {
+		return nil, &FieldError{Field: "timezone", Reason: fmt.Sprintf("%q is not an IANA time zone", name)}
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, &FieldError{Field: "timezone", Reason: fmt.Sprintf("unknown time zone %q", name)}
+	}
+	return loc, nil
+}
+
+// ParseOnce parses the time of a once schedule. A time with an offset is an
+// absolute instant, a time without one is a wall clock time of loc.
+func ParseOnce(value string, loc *time.Location) (time.Time, error) {
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return at, nil
+	}
+	for _, layout := range localLayouts {
+		if w, err := time.Parse(layout, value); err == nil {
+			return FromWallClock(w, loc), nil
+		}
+	}
+	return time.Time{}, &FieldError{Field: "once", Reason: fmt.Sprintf("%q is neither RFC 3339 nor a local date-time", value)}
+}
+
+// wallClock returns the wall clock of t as the same reading in UTC.
+func wallClock(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return time.Date(year, month, day, hour, min, sec, t.Nanosecond(), time.UTC)
+}
+
+// FromWallClock returns the instant at which the wall clock of loc shows w,
+// where w is the wall clock reading represented in UTC.
+//
+// Daylight saving time transitions are resolved as follows:
+//   - a wall clock time skipped when clocks move forward resolves to the end
+//     of the gap, i.e. the instant of the transition: 02:30 during a
+//     02:00 -> 03:00 jump becomes 03:00 of the new offset;
+//   - a wall clock time repeated when clocks move back resolves to its first
+//     occurrence, so cron schedules fire once and not twice.
+//
+// Transitions are assumed to be more than a day apart.
+func FromWallClock(w time.Time, loc *time.Location) time.Time {
+	var first time.Time
+	for _, probe := range []time.Time{w.Add(-24 * time.Hour), w.Add(24 * time.Hour)} {
+		_, offset := probe.In(loc).Zone()
+		at := w.Add(-time.Duration(offset) * time.Second).In(loc)
+		if _, o := at.Zone(); o != offset {
+			continue
+		}
+		if first.IsZero() || at.Before(first) {
+			first = at
+		}
+	}
+	if !first.IsZero() {
+		return first
+	}
+
+	// The wall clock time falls into a gap: it is reached by neither offset.
+	// Reading it with the offset after the transition lands before the
+	// transition, which ends the zone period of that instant.
+	_, after := w.Add(24 * time.Hour).In(loc).Zone()
+	_, end := w.Add(-time.Duration(after) * time.Second).In(loc).ZoneBounds()
+	return end.In(loc)
+}
@@ -0,0 +1,84 @@
+package entity_test
+
+import (
+	"scheduler/internal/entity"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestParseOnceTransitions(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		tz    string
+		value string
+		want  string
+	}{
+		{"offset is kept", "America/New_York", "2026-03-08T02:30:00+01:00", "2026-03-08T01:30:00Z"},
+		{"moscow without DST", "Europe/Moscow", "2026-03-29T02:30", "2026-03-28T23:30:00Z"},
+		// Moscow moved its clocks for the last times in 2011 and 2014
+		{"moscow gap", "Europe/Moscow", "2011-03-27T02:30", "2011-03-26T23:00:00Z"},
+		{"moscow overlap", "Europe/Moscow", "2014-10-26T01:30", "2014-10-25T21:30:00Z"},
+		// 02:00 EST -> 03:00 EDT, 02:30 is skipped and resolves to 03:00 EDT
+		{"new york gap", "America/New_York", "2026-03-08T02:30", "2026-03-08T07:00:00Z"},
+		{"new york before the gap", "America/New_York", "2026-03-08T01:59:59", "2026-03-08T06:59:59Z"},
+		// 02:00 EDT -> 01:00 EST, 01:30 happens twice and resolves to the first time, in EDT
+		{"new york overlap", "America/New_York", "2026-11-01T01:30", "2026-11-01T05:30:00Z"},
+		// Lord Howe shifts by 30 minutes: 02:00 +10:30 -> 02:30 +11
+		{"lord howe gap", "Australia/Lord_Howe", "2026-10-04T02:15", "2026-10-03T15:30:00Z"},
+		{"lord howe after the gap", "Australia/Lord_Howe", "2026-10-04T02:30", "2026-10-03T15:30:00Z"},
+		// 02:00 +11 -> 01:30 +10:30, 01:45 resolves to the first time, in +11
+		{"lord howe overlap", "Australia/Lord_Howe", "2026-04-05T01:45", "2026-04-04T14:45:00Z"},
+	} {
+		loc, err := entity.LoadTimezone(tc.tz)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		got, err := entity.ParseOnce(tc.value, loc)
+		if err != nil {
+			t.Errorf("%s: parse %q: %v", tc.name, tc.value, err)
+			continue
+		}
+		if want, _ := time.Parse(time.RFC3339, tc.want); !got.Equal(want) {
+			t.Errorf("%s: %q in %s = %v, want %s", tc.name, tc.value, tc.tz, got.UTC(), tc.want)
+		}
+	}
+}
+
+func TestCronNextTransitions(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		tz   string
+		expr string
+		from string
+		want []string
+	}{
+		{"moscow daily", "Europe/Moscow", "0 2 * * *", "2026-03-28T00:00:00Z", []string{"2026-03-28T23:00:00Z", "2026-03-29T23:00:00Z"}},
+		{"moscow gap", "Europe/Moscow", "30 2 * * *", "2011-03-26T00:00:00Z", []string{"2011-03-26T23:00:00Z", "2011-03-27T22:30:00Z"}},
+		// A skipped time fires at the end of the gap, the next day at the usual time
+		{"new york gap", "America/New_York", "30 2 * * *", "2026-03-07T12:00:00Z", []string{"2026-03-08T07:00:00Z", "2026-03-09T06:30:00Z"}},
+		// A repeated time fires once, at its first occurrence
+		{"new york overlap", "America/New_York", "30 1 * * *", "2026-10-31T12:00:00Z", []string{"2026-11-01T05:30:00Z", "2026-11-02T06:30:00Z"}},
+		{"new york hourly overlap", "America/New_York", "0 * * * *", "2026-11-01T04:30:00Z", []string{"2026-11-01T05:00:00Z", "2026-11-01T07:00:00Z"}},
+		{"lord howe gap", "Australia/Lord_Howe", "15 2 * * *", "2026-10-03T00:00:00Z", []string{"2026-10-03T15:30:00Z", "2026-10-04T15:15:00Z"}},
+		{"lord howe overlap", "Australia/Lord_Howe", "45 1 * * *", "2026-04-04T00:00:00Z", []string{"2026-04-04T14:45:00Z", "2026-04-05T15:15:00Z"}},
+	} {
+		loc, err := entity.LoadTimezone(tc.tz)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		s, err := entity.ParseCron(tc.expr, loc)
+		if err != nil {
+			t.Fatalf("%s: parse %q: %v", tc.name, tc.expr, err)
+		}
+		next, _ := time.Parse(time.RFC3339, tc.from)
+		for _, want := range tc.want {
+			var ok bool
+			w, _ := time.Parse(time.RFC3339, want)
+			if next, ok = s.Next(next); !ok || !next.Equal(w) {
+				t.Errorf("%s: %q next = %v, %v; want %s", tc.name, tc.expr, next.UTC(), ok, want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+)
+
+// NodeStatus is the state of a workflow node within a run.
+type NodeStatus string
+
+const (
+	// NodePending waits for the nodes it depends on.
+	NodePending   NodeStatus = "pending"
+	NodeQueued    NodeStatus = "queued"
+	NodeRunning   NodeStatus = "running"
+	NodeCompleted NodeStatus = "completed"
+	// NodeFailed failed for good, i.e. its retries are exhausted.
+	NodeFailed NodeStatus = "failed"
+	// NodeSkipped will not run because a node it depends on failed or was skipped.
+	NodeSkipped NodeStatus = "skipped"
+)
+
+// Workflow is a DAG of jobs: a node starts once all the nodes it depends on
+// have completed successfully within the same run.
+type Workflow struct {
+	ID        string         `json:"id"`
+	Name      string         `json:"name,omitempty"`
+	CreatedAt int64          `json:"createdAt"`
+	Nodes     []WorkflowNode `json:"nodes"`
+}
+
+// WorkflowNode is a job of the workflow. Its job has no schedule of its own
+// and runs only as part of workflow runs.
+type WorkflowNode struct {
+	Name      string   `json:"name"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// Job is the template of the node job, its schedule fields are ignored.
+	Job Job `json:"job"`
+}
+
+// WorkflowRun is a single pass through the workflow.
+type WorkflowRun struct {
+	ID         string            `json:"id"`
+	WorkflowID string            `json:"workflowId"`
+	Status     Status            `json:"status"`
+	CreatedAt  int64             `json:"createdAt"`
+	FinishedAt int64             `json:"finishedAt,omitempty"`
+	Nodes      []WorkflowRunNode `json:"nodes"`
+}
+
+// WorkflowRunNode is the state of a node within a run, Execution is its latest execution.
+type WorkflowRunNode struct {
+	Name      string     `json:"name"`
+	JobID     string     `json:"jobId"`
+	Status    NodeStatus `json:"status"`
+	Execution *Execution `json:"execution,omitempty"`
+}
+
+// Validate checks node names and dependencies and rejects cycles.
+func (w *Workflow) Validate() []FieldError {
+	if len(w.Nodes) == 0 {
+		return []FieldError{{Field: "nodes", Reason: "at least one node is required"}}
+	}
+
+	var errs []FieldError
+	names := make(map[string]bool, len(w.Nodes))
+	for i, n := range w.Nodes {
+		field := fmt.Sprintf("nodes[%d].name", i)
+		switch {
+		case n.Name == "":
+			errs = append(errs, FieldError{Field: field, Reason: "is required"})
+		case names[n.Name]:
+			errs = append(errs, FieldError{Field: field, Reason: fmt.Sprintf("duplicate node %q", n.Name)})
+		}
+		names[n.Name] = true
+	}
+	for i, n := range w.Nodes {
+		for j, dep := range n.DependsOn {
+			field := fmt.Sprintf("nodes[%d].dependsOn[%d]", i, j)
+			switch {
+			case dep == n.Name:
+				errs = append(errs, FieldError{Field: field, Reason: "a node can't depend on itself"})
+			case !names[dep]:
+				errs = append(errs, FieldError{Field: field, Reason: fmt.Sprintf("unknown node %q", dep)})
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if cycle := w.cycle(); cycle != nil {
+		return []FieldError{{Field: "nodes", Reason: fmt.Sprintf("dependency cycle %v", cycle)}}
+	}
+	return nil
+}
+
+// cycle returns the names of the nodes forming a dependency cycle, nil for a DAG.
+func (w *Workflow) cycle() []string {
+	deps := make(map[string][]string, len(w.Nodes))
+	for _, n := range w.Nodes {
+		deps[n.Name] = n.DependsOn
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(w.Nodes))
+	var path []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visiting:
+			start := slices.Index(path, name)
+			return append(slices.Clone(path[start:]), name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range deps[name] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, n := range w.Nodes {
+		if cycle := visit(n.Name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// Advance resolves the pending nodes of a run given the statuses of the nodes
+// which have executions. It returns the statuses of all nodes, the nodes to start
+// now, and the status of the run: Running while nodes are active or ready,
+// otherwise Completed if every node completed and Failed if not.
+func (w *Workflow) Advance(statuses map[string]NodeStatus) (map[string]NodeStatus, []WorkflowNode, Status) {
+	resolved := make(map[string]NodeStatus, len(w.Nodes))
+	for name, status := range statuses {
+		resolved[name] = status
+	}
+
+	// Nodes are resolved in rounds, a DAG settles in at most len(w.Nodes) of them
+	for changed := true; changed; {
+		changed = false
+		for _, n := range w.Nodes {
+			if s, ok := resolved[n.Name]; ok && s != NodePending {
+				continue
+			}
+			status := n.resolve(resolved)
+			if resolved[n.Name] != status {
+				resolved[n.Name] = status
+				changed = true
+			}
+		}
+	}
+
+	var ready []WorkflowNode
+	runStatus := Completed
+	for _, n := range w.Nodes {
+		switch s := resolved[n.Name]; {
+		case s == NodePending && n.dependenciesCompleted(resolved):
+			ready = append(ready, n)
+			runStatus = Running
+		case s == NodePending || s == NodeQueued || s == NodeRunning:
+			runStatus = Running
+		case (s == NodeFailed || s == NodeSkipped) && runStatus == Completed:
+			runStatus = Failed
+		}
+	}
+	return resolved, ready, runStatus
+}
+
+func (n WorkflowNode) resolve(statuses map[string]NodeStatus) NodeStatus {
+	for _, dep := range n.DependsOn {
+		if s := statuses[dep]; s == NodeFailed || s == NodeSkipped {
+			return NodeSkipped
+		}
+	}
+	return NodePending
+}
+
+func (n WorkflowNode) dependenciesCompleted(statuses map[string]NodeStatus) bool {
+	for _, dep := range n.DependsOn {
+		if statuses[dep] != NodeCompleted {
+			return false
+		}
+	}
+	return true
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -16,6 +17,27 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List runs which failed after exhausting their retry policy
+	// (GET /dead-letters)
+	GetDeadLetters(w http.ResponseWriter, r *http.Request)
+	// Discard a dead letter
+	// (DELETE /dead-letters/{dead_letter_id})
+	DeleteDeadLettersDeadLetterId(w http.ResponseWriter, r *http.Request, deadLetterId string)
+	// Run the job again with a fresh retry budget and remove the dead letter
+	// (POST /dead-letters/{dead_letter_id}/requeue)
+	PostDeadLettersDeadLetterIdRequeue(w http.ResponseWriter, r *http.Request, deadLetterId string)
+	// Complete the execution
+	// (POST /executions/{execution_id}/complete)
+	PostExecutionsExecutionIdComplete(w http.ResponseWriter, r *http.Request, executionId string)
+	// Report that the worker is still running the execution and extend its lease
+	// (POST /executions/{execution_id}/heartbeat)
+	PostExecutionsExecutionIdHeartbeat(w http.ResponseWriter, r *http.Request, executionId string)
+	// Read the logs of the execution
+	// (GET /executions/{execution_id}/logs)
+	GetExecutionsExecutionIdLogs(w http.ResponseWriter, r *http.Request, executionId string, params GetExecutionsExecutionIdLogsParams)
+	// Append chunks of the output of the execution
+	// (POST /executions/{execution_id}/logs)
+	PostExecutionsExecutionIdLogs(w http.ResponseWriter, r *http.Request, executionId string)
 	// List jobs
 	// (GET /jobs)
 	GetJobs(w http.ResponseWriter, r *http.Request, params GetJobsParams)
@@ -31,12 +53,81 @@ type ServerInterface interface {
 	// Get job executions
 	// (GET /jobs/{job_id}/executions)
 	GetJobsJobIdExecutions(w http.ResponseWriter, r *http.Request, jobId string, params GetJobsJobIdExecutionsParams)
+	// Get deliveries of the job notifications, the latest first
+	// (GET /jobs/{job_id}/notifications)
+	GetJobsJobIdNotifications(w http.ResponseWriter, r *http.Request, jobId string)
+	// Stop firing the job until it is resumed
+	// (POST /jobs/{job_id}/pause)
+	PostJobsJobIdPause(w http.ResponseWriter, r *http.Request, jobId string)
+	// Let the paused job fire again
+	// (POST /jobs/{job_id}/resume)
+	PostJobsJobIdResume(w http.ResponseWriter, r *http.Request, jobId string, params PostJobsJobIdResumeParams)
+	// Run the job now without changing its schedule
+	// (POST /jobs/{job_id}/trigger)
+	PostJobsJobIdTrigger(w http.ResponseWriter, r *http.Request, jobId string)
+	// Lease queued executions
+	// (POST /workers/{worker_id}/lease)
+	PostWorkersWorkerIdLease(w http.ResponseWriter, r *http.Request, workerId string, params PostWorkersWorkerIdLeaseParams)
+	// Create a workflow, a DAG of jobs started when their dependencies complete
+	// (POST /workflows)
+	PostWorkflows(w http.ResponseWriter, r *http.Request)
+	// Get workflow details
+	// (GET /workflows/{workflow_id})
+	GetWorkflowsWorkflowId(w http.ResponseWriter, r *http.Request, workflowId string)
+	// Start a run of the workflow
+	// (POST /workflows/{workflow_id}/runs)
+	PostWorkflowsWorkflowIdRuns(w http.ResponseWriter, r *http.Request, workflowId string)
+	// Get the status of a workflow run and of each of its nodes
+	// (GET /workflows/{workflow_id}/runs/{run_id})
+	GetWorkflowsWorkflowIdRunsRunId(w http.ResponseWriter, r *http.Request, workflowId string, runId string)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List runs which failed after exhausting their retry policy
+// (GET /dead-letters)
+func (_ Unimplemented) GetDeadLetters(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Discard a dead letter
+// (DELETE /dead-letters/{dead_letter_id})
+func (_ Unimplemented) DeleteDeadLettersDeadLetterId(w http.ResponseWriter, r *http.Request, deadLetterId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run the job again with a fresh retry budget and remove the dead letter
+// (POST /dead-letters/{dead_letter_id}/requeue)
+func (_ Unimplemented) PostDeadLettersDeadLetterIdRequeue(w http.ResponseWriter, r *http.Request, deadLetterId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete the execution
+// (POST /executions/{execution_id}/complete)
+func (_ Unimplemented) PostExecutionsExecutionIdComplete(w http.ResponseWriter, r *http.Request, executionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report that the worker is still running the execution and extend its lease
+// (POST /executions/{execution_id}/heartbeat)
+func (_ Unimplemented) PostExecutionsExecutionIdHeartbeat(w http.ResponseWriter, r *http.Request, executionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read the logs of the execution
+// (GET /executions/{execution_id}/logs)
+func (_ Unimplemented) GetExecutionsExecutionIdLogs(w http.ResponseWriter, r *http.Request, executionId string, params GetExecutionsExecutionIdLogsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Append chunks of the output of the execution
+// (POST /executions/{execution_id}/logs)
+func (_ Unimplemented) PostExecutionsExecutionIdLogs(w http.ResponseWriter, r *http.Request, executionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List jobs
 // (GET /jobs)
 func (_ Unimplemented) GetJobs(w http.ResponseWriter, r *http.Request, params GetJobsParams) {
@@ -67,6 +158,60 @@ func (_ Unimplemented) GetJobsJobIdExecutions(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get deliveries of the job notifications, the latest first
+// (GET /jobs/{job_id}/notifications)
+func (_ Unimplemented) GetJobsJobIdNotifications(w http.ResponseWriter, r *http.Request, jobId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop firing the job until it is resumed
+// (POST /jobs/{job_id}/pause)
+func (_ Unimplemented) PostJobsJobIdPause(w http.ResponseWriter, r *http.Request, jobId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Let the paused job fire again
+// (POST /jobs/{job_id}/resume)
+func (_ Unimplemented) PostJobsJobIdResume(w http.ResponseWriter, r *http.Request, jobId string, params PostJobsJobIdResumeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run the job now without changing its schedule
+// (POST /jobs/{job_id}/trigger)
+func (_ Unimplemented) PostJobsJobIdTrigger(w http.ResponseWriter, r *http.Request, jobId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Lease queued executions
+// (POST /workers/{worker_id}/lease)
+func (_ Unimplemented) PostWorkersWorkerIdLease(w http.ResponseWriter, r *http.Request, workerId string, params PostWorkersWorkerIdLeaseParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a workflow, a DAG of jobs started when their dependencies complete
+// (POST /workflows)
+func (_ Unimplemented) PostWorkflows(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get workflow details
+// (GET /workflows/{workflow_id})
+func (_ Unimplemented) GetWorkflowsWorkflowId(w http.ResponseWriter, r *http.Request, workflowId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a run of the workflow
+// (POST /workflows/{workflow_id}/runs)
+func (_ Unimplemented) PostWorkflowsWorkflowIdRuns(w http.ResponseWriter, r *http.Request, workflowId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the status of a workflow run and of each of its nodes
+// (GET /workflows/{workflow_id}/runs/{run_id})
+func (_ Unimplemented) GetWorkflowsWorkflowIdRunsRunId(w http.ResponseWriter, r *http.Request, workflowId string, runId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -76,6 +221,217 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetDeadLetters(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeadLetters(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteDeadLettersDeadLetterId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDeadLettersDeadLetterId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dead_letter_id" -------------
+	var deadLetterId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dead_letter_id", runtime.ParamLocationPath, chi.URLParam(r, "dead_letter_id"), &deadLetterId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dead_letter_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDeadLettersDeadLetterId(w, r, deadLetterId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostDeadLettersDeadLetterIdRequeue operation middleware
+func (siw *ServerInterfaceWrapper) PostDeadLettersDeadLetterIdRequeue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "dead_letter_id" -------------
+	var deadLetterId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "dead_letter_id", runtime.ParamLocationPath, chi.URLParam(r, "dead_letter_id"), &deadLetterId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dead_letter_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostDeadLettersDeadLetterIdRequeue(w, r, deadLetterId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostExecutionsExecutionIdComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostExecutionsExecutionIdComplete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "execution_id", runtime.ParamLocationPath, chi.URLParam(r, "execution_id"), &executionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostExecutionsExecutionIdComplete(w, r, executionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostExecutionsExecutionIdHeartbeat operation middleware
+func (siw *ServerInterfaceWrapper) PostExecutionsExecutionIdHeartbeat(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "execution_id", runtime.ParamLocationPath, chi.URLParam(r, "execution_id"), &executionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostExecutionsExecutionIdHeartbeat(w, r, executionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetExecutionsExecutionIdLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetExecutionsExecutionIdLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "execution_id", runtime.ParamLocationPath, chi.URLParam(r, "execution_id"), &executionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExecutionsExecutionIdLogsParams
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExecutionsExecutionIdLogs(w, r, executionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostExecutionsExecutionIdLogs operation middleware
+func (siw *ServerInterfaceWrapper) PostExecutionsExecutionIdLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "execution_id", runtime.ParamLocationPath, chi.URLParam(r, "execution_id"), &executionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostExecutionsExecutionIdLogs(w, r, executionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetJobs operation middleware
 func (siw *ServerInterfaceWrapper) GetJobs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -93,6 +449,70 @@ func (siw *ServerInterfaceWrapper) GetJobs(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	// ------------- Optional query parameter "schedule" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schedule", r.URL.Query(), &params.Schedule)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schedule", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "selector", r.URL.Query(), &params.Selector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "selector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetJobs(w, r, params)
 	}))
@@ -197,25 +617,327 @@ func (siw *ServerInterfaceWrapper) GetJobsJobIdExecutions(w http.ResponseWriter,
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetJobsJobIdExecutions(w, r, jobId, params)
-	}))
+	// ------------- Optional query parameter "status" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "startedAfter" -------------
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "startedAfter", r.URL.Query(), &params.StartedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startedAfter", Err: err})
+		return
+	}
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	// ------------- Optional query parameter "startedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startedBefore", r.URL.Query(), &params.StartedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startedBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJobsJobIdExecutions(w, r, jobId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetJobsJobIdNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetJobsJobIdNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, chi.URLParam(r, "job_id"), &jobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJobsJobIdNotifications(w, r, jobId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostJobsJobIdPause operation middleware
+func (siw *ServerInterfaceWrapper) PostJobsJobIdPause(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, chi.URLParam(r, "job_id"), &jobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostJobsJobIdPause(w, r, jobId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostJobsJobIdResume operation middleware
+func (siw *ServerInterfaceWrapper) PostJobsJobIdResume(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, chi.URLParam(r, "job_id"), &jobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostJobsJobIdResumeParams
+
+	// ------------- Optional query parameter "misfirePolicy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "misfirePolicy", r.URL.Query(), &params.MisfirePolicy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "misfirePolicy", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostJobsJobIdResume(w, r, jobId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostJobsJobIdTrigger operation middleware
+func (siw *ServerInterfaceWrapper) PostJobsJobIdTrigger(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, chi.URLParam(r, "job_id"), &jobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostJobsJobIdTrigger(w, r, jobId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostWorkersWorkerIdLease operation middleware
+func (siw *ServerInterfaceWrapper) PostWorkersWorkerIdLease(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, chi.URLParam(r, "worker_id"), &workerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "worker_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWorkersWorkerIdLeaseParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWorkersWorkerIdLease(w, r, workerId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostWorkflows operation middleware
+func (siw *ServerInterfaceWrapper) PostWorkflows(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWorkflows(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWorkflowsWorkflowId operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowsWorkflowId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "workflow_id" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow_id", runtime.ParamLocationPath, chi.URLParam(r, "workflow_id"), &workflowId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkflowsWorkflowId(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostWorkflowsWorkflowIdRuns operation middleware
+func (siw *ServerInterfaceWrapper) PostWorkflowsWorkflowIdRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "workflow_id" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow_id", runtime.ParamLocationPath, chi.URLParam(r, "workflow_id"), &workflowId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWorkflowsWorkflowIdRuns(w, r, workflowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWorkflowsWorkflowIdRunsRunId operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflowsWorkflowIdRunsRunId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "workflow_id" -------------
+	var workflowId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow_id", runtime.ParamLocationPath, chi.URLParam(r, "workflow_id"), &workflowId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workflow_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "run_id" -------------
+	var runId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "run_id", runtime.ParamLocationPath, chi.URLParam(r, "run_id"), &runId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkflowsWorkflowIdRunsRunId(w, r, workflowId, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
 
 func (e *UnescapedCookieParamError) Unwrap() error {
 	return e.Err
@@ -321,6 +1043,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dead-letters", wrapper.GetDeadLetters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/dead-letters/{dead_letter_id}", wrapper.DeleteDeadLettersDeadLetterId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/dead-letters/{dead_letter_id}/requeue", wrapper.PostDeadLettersDeadLetterIdRequeue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/executions/{execution_id}/complete", wrapper.PostExecutionsExecutionIdComplete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/executions/{execution_id}/heartbeat", wrapper.PostExecutionsExecutionIdHeartbeat)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/executions/{execution_id}/logs", wrapper.GetExecutionsExecutionIdLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/executions/{execution_id}/logs", wrapper.PostExecutionsExecutionIdLogs)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/jobs", wrapper.GetJobs)
 	})
@@ -336,10 +1079,287 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/jobs/{job_id}/executions", wrapper.GetJobsJobIdExecutions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/jobs/{job_id}/notifications", wrapper.GetJobsJobIdNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/jobs/{job_id}/pause", wrapper.PostJobsJobIdPause)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/jobs/{job_id}/resume", wrapper.PostJobsJobIdResume)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/jobs/{job_id}/trigger", wrapper.PostJobsJobIdTrigger)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workers/{worker_id}/lease", wrapper.PostWorkersWorkerIdLease)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workflows", wrapper.PostWorkflows)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflows/{workflow_id}", wrapper.GetWorkflowsWorkflowId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/workflows/{workflow_id}/runs", wrapper.PostWorkflowsWorkflowIdRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/workflows/{workflow_id}/runs/{run_id}", wrapper.GetWorkflowsWorkflowIdRunsRunId)
+	})
+
+	return r
+}
+
+type GetDeadLettersRequestObject struct {
+}
+
+type GetDeadLettersResponseObject interface {
+	VisitGetDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type GetDeadLetters200JSONResponse []DeadLetter
+
+func (response GetDeadLetters200JSONResponse) VisitGetDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDeadLettersDeadLetterIdRequestObject struct {
+	DeadLetterId string `json:"dead_letter_id"`
+}
+
+type DeleteDeadLettersDeadLetterIdResponseObject interface {
+	VisitDeleteDeadLettersDeadLetterIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteDeadLettersDeadLetterId204Response struct {
+}
+
+func (response DeleteDeadLettersDeadLetterId204Response) VisitDeleteDeadLettersDeadLetterIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteDeadLettersDeadLetterId404Response struct {
+}
+
+func (response DeleteDeadLettersDeadLetterId404Response) VisitDeleteDeadLettersDeadLetterIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostDeadLettersDeadLetterIdRequeueRequestObject struct {
+	DeadLetterId string `json:"dead_letter_id"`
+}
+
+type PostDeadLettersDeadLetterIdRequeueResponseObject interface {
+	VisitPostDeadLettersDeadLetterIdRequeueResponse(w http.ResponseWriter) error
+}
+
+type PostDeadLettersDeadLetterIdRequeue201JSONResponse string
+
+func (response PostDeadLettersDeadLetterIdRequeue201JSONResponse) VisitPostDeadLettersDeadLetterIdRequeueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDeadLettersDeadLetterIdRequeue404Response struct {
+}
+
+func (response PostDeadLettersDeadLetterIdRequeue404Response) VisitPostDeadLettersDeadLetterIdRequeueResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostDeadLettersDeadLetterIdRequeue409Response struct {
+}
+
+func (response PostDeadLettersDeadLetterIdRequeue409Response) VisitPostDeadLettersDeadLetterIdRequeueResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PostExecutionsExecutionIdCompleteRequestObject struct {
+	ExecutionId string `json:"execution_id"`
+	Body        *PostExecutionsExecutionIdCompleteJSONRequestBody
+}
+
+type PostExecutionsExecutionIdCompleteResponseObject interface {
+	VisitPostExecutionsExecutionIdCompleteResponse(w http.ResponseWriter) error
+}
+
+type PostExecutionsExecutionIdComplete204Response struct {
+}
+
+func (response PostExecutionsExecutionIdComplete204Response) VisitPostExecutionsExecutionIdCompleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostExecutionsExecutionIdComplete400Response struct {
+}
+
+func (response PostExecutionsExecutionIdComplete400Response) VisitPostExecutionsExecutionIdCompleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostExecutionsExecutionIdComplete404Response struct {
+}
+
+func (response PostExecutionsExecutionIdComplete404Response) VisitPostExecutionsExecutionIdCompleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostExecutionsExecutionIdComplete409Response struct {
+}
+
+func (response PostExecutionsExecutionIdComplete409Response) VisitPostExecutionsExecutionIdCompleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PostExecutionsExecutionIdHeartbeatRequestObject struct {
+	ExecutionId string `json:"execution_id"`
+	Body        *PostExecutionsExecutionIdHeartbeatJSONRequestBody
+}
+
+type PostExecutionsExecutionIdHeartbeatResponseObject interface {
+	VisitPostExecutionsExecutionIdHeartbeatResponse(w http.ResponseWriter) error
+}
+
+type PostExecutionsExecutionIdHeartbeat200JSONResponse HeartbeatResult
+
+func (response PostExecutionsExecutionIdHeartbeat200JSONResponse) VisitPostExecutionsExecutionIdHeartbeatResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExecutionsExecutionIdHeartbeat400Response struct {
+}
+
+func (response PostExecutionsExecutionIdHeartbeat400Response) VisitPostExecutionsExecutionIdHeartbeatResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostExecutionsExecutionIdHeartbeat404Response struct {
+}
+
+func (response PostExecutionsExecutionIdHeartbeat404Response) VisitPostExecutionsExecutionIdHeartbeatResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostExecutionsExecutionIdHeartbeat409Response struct {
+}
+
+func (response PostExecutionsExecutionIdHeartbeat409Response) VisitPostExecutionsExecutionIdHeartbeatResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type GetExecutionsExecutionIdLogsRequestObject struct {
+	ExecutionId string `json:"execution_id"`
+	Params      GetExecutionsExecutionIdLogsParams
+}
+
+type GetExecutionsExecutionIdLogsResponseObject interface {
+	VisitGetExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error
+}
+
+type GetExecutionsExecutionIdLogs200JSONResponse []ExecutionLog
+
+func (response GetExecutionsExecutionIdLogs200JSONResponse) VisitGetExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExecutionsExecutionIdLogs200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetExecutionsExecutionIdLogs200TexteventStreamResponse) VisitGetExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExecutionsExecutionIdLogs400Response struct {
+}
+
+func (response GetExecutionsExecutionIdLogs400Response) VisitGetExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GetExecutionsExecutionIdLogs404Response struct {
+}
+
+func (response GetExecutionsExecutionIdLogs404Response) VisitGetExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostExecutionsExecutionIdLogsRequestObject struct {
+	ExecutionId string `json:"execution_id"`
+	Body        *PostExecutionsExecutionIdLogsJSONRequestBody
+}
+
+type PostExecutionsExecutionIdLogsResponseObject interface {
+	VisitPostExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error
+}
+
+type PostExecutionsExecutionIdLogs204Response struct {
+}
+
+func (response PostExecutionsExecutionIdLogs204Response) VisitPostExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostExecutionsExecutionIdLogs400Response struct {
+}
+
+func (response PostExecutionsExecutionIdLogs400Response) VisitPostExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostExecutionsExecutionIdLogs404Response struct {
+}
+
+func (response PostExecutionsExecutionIdLogs404Response) VisitPostExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostExecutionsExecutionIdLogs409Response struct {
+}
+
+func (response PostExecutionsExecutionIdLogs409Response) VisitPostExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
 
-	return r
-}
-
 type GetJobsRequestObject struct {
 	Params GetJobsParams
 }
@@ -348,7 +1368,7 @@ type GetJobsResponseObject interface {
 	VisitGetJobsResponse(w http.ResponseWriter) error
 }
 
-type GetJobs200JSONResponse []Job
+type GetJobs200JSONResponse JobPage
 
 func (response GetJobs200JSONResponse) VisitGetJobsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
@@ -357,6 +1377,15 @@ func (response GetJobs200JSONResponse) VisitGetJobsResponse(w http.ResponseWrite
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetJobs400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetJobs400ApplicationProblemPlusJSONResponse) VisitGetJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PostJobsRequestObject struct {
 	Body *PostJobsJSONRequestBody
 }
@@ -374,12 +1403,13 @@ func (response PostJobs201JSONResponse) VisitPostJobsResponse(w http.ResponseWri
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostJobs400Response struct {
-}
+type PostJobs400ApplicationProblemPlusJSONResponse Problem
 
-func (response PostJobs400Response) VisitPostJobsResponse(w http.ResponseWriter) error {
+func (response PostJobs400ApplicationProblemPlusJSONResponse) VisitPostJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 type DeleteJobsJobIdRequestObject struct {
@@ -440,69 +1470,589 @@ type GetJobsJobIdExecutionsResponseObject interface {
 	VisitGetJobsJobIdExecutionsResponse(w http.ResponseWriter) error
 }
 
-type GetJobsJobIdExecutions200JSONResponse []Execution
+type GetJobsJobIdExecutions200JSONResponse ExecutionPage
 
 func (response GetJobsJobIdExecutions200JSONResponse) VisitGetJobsJobIdExecutionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobsJobIdExecutions400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetJobsJobIdExecutions400ApplicationProblemPlusJSONResponse) VisitGetJobsJobIdExecutionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobsJobIdExecutions404Response struct {
+}
+
+func (response GetJobsJobIdExecutions404Response) VisitGetJobsJobIdExecutionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetJobsJobIdNotificationsRequestObject struct {
+	JobId string `json:"job_id"`
+}
+
+type GetJobsJobIdNotificationsResponseObject interface {
+	VisitGetJobsJobIdNotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetJobsJobIdNotifications200JSONResponse []NotificationDelivery
+
+func (response GetJobsJobIdNotifications200JSONResponse) VisitGetJobsJobIdNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobsJobIdNotifications404Response struct {
+}
+
+func (response GetJobsJobIdNotifications404Response) VisitGetJobsJobIdNotificationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostJobsJobIdPauseRequestObject struct {
+	JobId string `json:"job_id"`
+}
+
+type PostJobsJobIdPauseResponseObject interface {
+	VisitPostJobsJobIdPauseResponse(w http.ResponseWriter) error
+}
+
+type PostJobsJobIdPause204Response struct {
+}
+
+func (response PostJobsJobIdPause204Response) VisitPostJobsJobIdPauseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostJobsJobIdPause404Response struct {
+}
+
+func (response PostJobsJobIdPause404Response) VisitPostJobsJobIdPauseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostJobsJobIdPause409Response struct {
+}
+
+func (response PostJobsJobIdPause409Response) VisitPostJobsJobIdPauseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PostJobsJobIdResumeRequestObject struct {
+	JobId  string `json:"job_id"`
+	Params PostJobsJobIdResumeParams
+}
+
+type PostJobsJobIdResumeResponseObject interface {
+	VisitPostJobsJobIdResumeResponse(w http.ResponseWriter) error
+}
+
+type PostJobsJobIdResume204Response struct {
+}
+
+func (response PostJobsJobIdResume204Response) VisitPostJobsJobIdResumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostJobsJobIdResume400Response struct {
+}
+
+func (response PostJobsJobIdResume400Response) VisitPostJobsJobIdResumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PostJobsJobIdResume404Response struct {
+}
+
+func (response PostJobsJobIdResume404Response) VisitPostJobsJobIdResumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostJobsJobIdResume409Response struct {
+}
+
+func (response PostJobsJobIdResume409Response) VisitPostJobsJobIdResumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PostJobsJobIdTriggerRequestObject struct {
+	JobId string `json:"job_id"`
+	Body  *PostJobsJobIdTriggerJSONRequestBody
+}
+
+type PostJobsJobIdTriggerResponseObject interface {
+	VisitPostJobsJobIdTriggerResponse(w http.ResponseWriter) error
+}
+
+type PostJobsJobIdTrigger201JSONResponse string
+
+func (response PostJobsJobIdTrigger201JSONResponse) VisitPostJobsJobIdTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostJobsJobIdTrigger400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostJobsJobIdTrigger400ApplicationProblemPlusJSONResponse) VisitPostJobsJobIdTriggerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostJobsJobIdTrigger404Response struct {
+}
+
+func (response PostJobsJobIdTrigger404Response) VisitPostJobsJobIdTriggerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostJobsJobIdTrigger409Response struct {
+}
+
+func (response PostJobsJobIdTrigger409Response) VisitPostJobsJobIdTriggerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PostWorkersWorkerIdLeaseRequestObject struct {
+	WorkerId string `json:"worker_id"`
+	Params   PostWorkersWorkerIdLeaseParams
+}
+
+type PostWorkersWorkerIdLeaseResponseObject interface {
+	VisitPostWorkersWorkerIdLeaseResponse(w http.ResponseWriter) error
+}
+
+type PostWorkersWorkerIdLease200JSONResponse []Lease
+
+func (response PostWorkersWorkerIdLease200JSONResponse) VisitPostWorkersWorkerIdLeaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWorkflowsRequestObject struct {
+	Body *PostWorkflowsJSONRequestBody
+}
+
+type PostWorkflowsResponseObject interface {
+	VisitPostWorkflowsResponse(w http.ResponseWriter) error
+}
+
+type PostWorkflows201JSONResponse string
+
+func (response PostWorkflows201JSONResponse) VisitPostWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWorkflows400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostWorkflows400ApplicationProblemPlusJSONResponse) VisitPostWorkflowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowsWorkflowIdRequestObject struct {
+	WorkflowId string `json:"workflow_id"`
+}
+
+type GetWorkflowsWorkflowIdResponseObject interface {
+	VisitGetWorkflowsWorkflowIdResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowsWorkflowId200JSONResponse Workflow
+
+func (response GetWorkflowsWorkflowId200JSONResponse) VisitGetWorkflowsWorkflowIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowsWorkflowId404Response struct {
+}
+
+func (response GetWorkflowsWorkflowId404Response) VisitGetWorkflowsWorkflowIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostWorkflowsWorkflowIdRunsRequestObject struct {
+	WorkflowId string `json:"workflow_id"`
+}
+
+type PostWorkflowsWorkflowIdRunsResponseObject interface {
+	VisitPostWorkflowsWorkflowIdRunsResponse(w http.ResponseWriter) error
+}
+
+type PostWorkflowsWorkflowIdRuns201JSONResponse string
+
+func (response PostWorkflowsWorkflowIdRuns201JSONResponse) VisitPostWorkflowsWorkflowIdRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWorkflowsWorkflowIdRuns404Response struct {
+}
+
+func (response PostWorkflowsWorkflowIdRuns404Response) VisitPostWorkflowsWorkflowIdRunsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetWorkflowsWorkflowIdRunsRunIdRequestObject struct {
+	WorkflowId string `json:"workflow_id"`
+	RunId      string `json:"run_id"`
+}
+
+type GetWorkflowsWorkflowIdRunsRunIdResponseObject interface {
+	VisitGetWorkflowsWorkflowIdRunsRunIdResponse(w http.ResponseWriter) error
+}
+
+type GetWorkflowsWorkflowIdRunsRunId200JSONResponse WorkflowRun
+
+func (response GetWorkflowsWorkflowIdRunsRunId200JSONResponse) VisitGetWorkflowsWorkflowIdRunsRunIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkflowsWorkflowIdRunsRunId404Response struct {
+}
+
+func (response GetWorkflowsWorkflowIdRunsRunId404Response) VisitGetWorkflowsWorkflowIdRunsRunIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List runs which failed after exhausting their retry policy
+	// (GET /dead-letters)
+	GetDeadLetters(ctx context.Context, request GetDeadLettersRequestObject) (GetDeadLettersResponseObject, error)
+	// Discard a dead letter
+	// (DELETE /dead-letters/{dead_letter_id})
+	DeleteDeadLettersDeadLetterId(ctx context.Context, request DeleteDeadLettersDeadLetterIdRequestObject) (DeleteDeadLettersDeadLetterIdResponseObject, error)
+	// Run the job again with a fresh retry budget and remove the dead letter
+	// (POST /dead-letters/{dead_letter_id}/requeue)
+	PostDeadLettersDeadLetterIdRequeue(ctx context.Context, request PostDeadLettersDeadLetterIdRequeueRequestObject) (PostDeadLettersDeadLetterIdRequeueResponseObject, error)
+	// Complete the execution
+	// (POST /executions/{execution_id}/complete)
+	PostExecutionsExecutionIdComplete(ctx context.Context, request PostExecutionsExecutionIdCompleteRequestObject) (PostExecutionsExecutionIdCompleteResponseObject, error)
+	// Report that the worker is still running the execution and extend its lease
+	// (POST /executions/{execution_id}/heartbeat)
+	PostExecutionsExecutionIdHeartbeat(ctx context.Context, request PostExecutionsExecutionIdHeartbeatRequestObject) (PostExecutionsExecutionIdHeartbeatResponseObject, error)
+	// Read the logs of the execution
+	// (GET /executions/{execution_id}/logs)
+	GetExecutionsExecutionIdLogs(ctx context.Context, request GetExecutionsExecutionIdLogsRequestObject) (GetExecutionsExecutionIdLogsResponseObject, error)
+	// Append chunks of the output of the execution
+	// (POST /executions/{execution_id}/logs)
+	PostExecutionsExecutionIdLogs(ctx context.Context, request PostExecutionsExecutionIdLogsRequestObject) (PostExecutionsExecutionIdLogsResponseObject, error)
+	// List jobs
+	// (GET /jobs)
+	GetJobs(ctx context.Context, request GetJobsRequestObject) (GetJobsResponseObject, error)
+	// Create a new job
+	// (POST /jobs)
+	PostJobs(ctx context.Context, request PostJobsRequestObject) (PostJobsResponseObject, error)
+	// Delete a job
+	// (DELETE /jobs/{job_id})
+	DeleteJobsJobId(ctx context.Context, request DeleteJobsJobIdRequestObject) (DeleteJobsJobIdResponseObject, error)
+	// Get job details
+	// (GET /jobs/{job_id})
+	GetJobsJobId(ctx context.Context, request GetJobsJobIdRequestObject) (GetJobsJobIdResponseObject, error)
+	// Get job executions
+	// (GET /jobs/{job_id}/executions)
+	GetJobsJobIdExecutions(ctx context.Context, request GetJobsJobIdExecutionsRequestObject) (GetJobsJobIdExecutionsResponseObject, error)
+	// Get deliveries of the job notifications, the latest first
+	// (GET /jobs/{job_id}/notifications)
+	GetJobsJobIdNotifications(ctx context.Context, request GetJobsJobIdNotificationsRequestObject) (GetJobsJobIdNotificationsResponseObject, error)
+	// Stop firing the job until it is resumed
+	// (POST /jobs/{job_id}/pause)
+	PostJobsJobIdPause(ctx context.Context, request PostJobsJobIdPauseRequestObject) (PostJobsJobIdPauseResponseObject, error)
+	// Let the paused job fire again
+	// (POST /jobs/{job_id}/resume)
+	PostJobsJobIdResume(ctx context.Context, request PostJobsJobIdResumeRequestObject) (PostJobsJobIdResumeResponseObject, error)
+	// Run the job now without changing its schedule
+	// (POST /jobs/{job_id}/trigger)
+	PostJobsJobIdTrigger(ctx context.Context, request PostJobsJobIdTriggerRequestObject) (PostJobsJobIdTriggerResponseObject, error)
+	// Lease queued executions
+	// (POST /workers/{worker_id}/lease)
+	PostWorkersWorkerIdLease(ctx context.Context, request PostWorkersWorkerIdLeaseRequestObject) (PostWorkersWorkerIdLeaseResponseObject, error)
+	// Create a workflow, a DAG of jobs started when their dependencies complete
+	// (POST /workflows)
+	PostWorkflows(ctx context.Context, request PostWorkflowsRequestObject) (PostWorkflowsResponseObject, error)
+	// Get workflow details
+	// (GET /workflows/{workflow_id})
+	GetWorkflowsWorkflowId(ctx context.Context, request GetWorkflowsWorkflowIdRequestObject) (GetWorkflowsWorkflowIdResponseObject, error)
+	// Start a run of the workflow
+	// (POST /workflows/{workflow_id}/runs)
+	PostWorkflowsWorkflowIdRuns(ctx context.Context, request PostWorkflowsWorkflowIdRunsRequestObject) (PostWorkflowsWorkflowIdRunsResponseObject, error)
+	// Get the status of a workflow run and of each of its nodes
+	// (GET /workflows/{workflow_id}/runs/{run_id})
+	GetWorkflowsWorkflowIdRunsRunId(ctx context.Context, request GetWorkflowsWorkflowIdRunsRunIdRequestObject) (GetWorkflowsWorkflowIdRunsRunIdResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHttpHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHttpMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetDeadLetters operation middleware
+func (sh *strictHandler) GetDeadLetters(w http.ResponseWriter, r *http.Request) {
+	var request GetDeadLettersRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDeadLetters(ctx, request.(GetDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDeadLettersResponseObject); ok {
+		if err := validResponse.VisitGetDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteDeadLettersDeadLetterId operation middleware
+func (sh *strictHandler) DeleteDeadLettersDeadLetterId(w http.ResponseWriter, r *http.Request, deadLetterId string) {
+	var request DeleteDeadLettersDeadLetterIdRequestObject
+
+	request.DeadLetterId = deadLetterId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDeadLettersDeadLetterId(ctx, request.(DeleteDeadLettersDeadLetterIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDeadLettersDeadLetterId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDeadLettersDeadLetterIdResponseObject); ok {
+		if err := validResponse.VisitDeleteDeadLettersDeadLetterIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDeadLettersDeadLetterIdRequeue operation middleware
+func (sh *strictHandler) PostDeadLettersDeadLetterIdRequeue(w http.ResponseWriter, r *http.Request, deadLetterId string) {
+	var request PostDeadLettersDeadLetterIdRequeueRequestObject
+
+	request.DeadLetterId = deadLetterId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDeadLettersDeadLetterIdRequeue(ctx, request.(PostDeadLettersDeadLetterIdRequeueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDeadLettersDeadLetterIdRequeue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDeadLettersDeadLetterIdRequeueResponseObject); ok {
+		if err := validResponse.VisitPostDeadLettersDeadLetterIdRequeueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostExecutionsExecutionIdComplete operation middleware
+func (sh *strictHandler) PostExecutionsExecutionIdComplete(w http.ResponseWriter, r *http.Request, executionId string) {
+	var request PostExecutionsExecutionIdCompleteRequestObject
+
+	request.ExecutionId = executionId
+
+	var body PostExecutionsExecutionIdCompleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostExecutionsExecutionIdComplete(ctx, request.(PostExecutionsExecutionIdCompleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostExecutionsExecutionIdComplete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostExecutionsExecutionIdCompleteResponseObject); ok {
+		if err := validResponse.VisitPostExecutionsExecutionIdCompleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostExecutionsExecutionIdHeartbeat operation middleware
+func (sh *strictHandler) PostExecutionsExecutionIdHeartbeat(w http.ResponseWriter, r *http.Request, executionId string) {
+	var request PostExecutionsExecutionIdHeartbeatRequestObject
+
+	request.ExecutionId = executionId
+
+	var body PostExecutionsExecutionIdHeartbeatJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostExecutionsExecutionIdHeartbeat(ctx, request.(PostExecutionsExecutionIdHeartbeatRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostExecutionsExecutionIdHeartbeat")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostExecutionsExecutionIdHeartbeatResponseObject); ok {
+		if err := validResponse.VisitPostExecutionsExecutionIdHeartbeatResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExecutionsExecutionIdLogs operation middleware
+func (sh *strictHandler) GetExecutionsExecutionIdLogs(w http.ResponseWriter, r *http.Request, executionId string, params GetExecutionsExecutionIdLogsParams) {
+	var request GetExecutionsExecutionIdLogsRequestObject
+
+	request.ExecutionId = executionId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExecutionsExecutionIdLogs(ctx, request.(GetExecutionsExecutionIdLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExecutionsExecutionIdLogs")
+	}
 
-type GetJobsJobIdExecutions404Response struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func (response GetJobsJobIdExecutions404Response) VisitGetJobsJobIdExecutionsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExecutionsExecutionIdLogsResponseObject); ok {
+		if err := validResponse.VisitGetExecutionsExecutionIdLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List jobs
-	// (GET /jobs)
-	GetJobs(ctx context.Context, request GetJobsRequestObject) (GetJobsResponseObject, error)
-	// Create a new job
-	// (POST /jobs)
-	PostJobs(ctx context.Context, request PostJobsRequestObject) (PostJobsResponseObject, error)
-	// Delete a job
-	// (DELETE /jobs/{job_id})
-	DeleteJobsJobId(ctx context.Context, request DeleteJobsJobIdRequestObject) (DeleteJobsJobIdResponseObject, error)
-	// Get job details
-	// (GET /jobs/{job_id})
-	GetJobsJobId(ctx context.Context, request GetJobsJobIdRequestObject) (GetJobsJobIdResponseObject, error)
-	// Get job executions
-	// (GET /jobs/{job_id}/executions)
-	GetJobsJobIdExecutions(ctx context.Context, request GetJobsJobIdExecutionsRequestObject) (GetJobsJobIdExecutionsResponseObject, error)
-}
+// PostExecutionsExecutionIdLogs operation middleware
+func (sh *strictHandler) PostExecutionsExecutionIdLogs(w http.ResponseWriter, r *http.Request, executionId string) {
+	var request PostExecutionsExecutionIdLogsRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHttpHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHttpMiddlewareFunc
+	request.ExecutionId = executionId
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body PostExecutionsExecutionIdLogsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostExecutionsExecutionIdLogs(ctx, request.(PostExecutionsExecutionIdLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostExecutionsExecutionIdLogs")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostExecutionsExecutionIdLogsResponseObject); ok {
+		if err := validResponse.VisitPostExecutionsExecutionIdLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
 // GetJobs operation middleware
@@ -640,3 +2190,252 @@ func (sh *strictHandler) GetJobsJobIdExecutions(w http.ResponseWriter, r *http.R
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// GetJobsJobIdNotifications operation middleware
+func (sh *strictHandler) GetJobsJobIdNotifications(w http.ResponseWriter, r *http.Request, jobId string) {
+	var request GetJobsJobIdNotificationsRequestObject
+
+	request.JobId = jobId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJobsJobIdNotifications(ctx, request.(GetJobsJobIdNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJobsJobIdNotifications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobsJobIdNotificationsResponseObject); ok {
+		if err := validResponse.VisitGetJobsJobIdNotificationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostJobsJobIdPause operation middleware
+func (sh *strictHandler) PostJobsJobIdPause(w http.ResponseWriter, r *http.Request, jobId string) {
+	var request PostJobsJobIdPauseRequestObject
+
+	request.JobId = jobId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostJobsJobIdPause(ctx, request.(PostJobsJobIdPauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostJobsJobIdPause")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostJobsJobIdPauseResponseObject); ok {
+		if err := validResponse.VisitPostJobsJobIdPauseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostJobsJobIdResume operation middleware
+func (sh *strictHandler) PostJobsJobIdResume(w http.ResponseWriter, r *http.Request, jobId string, params PostJobsJobIdResumeParams) {
+	var request PostJobsJobIdResumeRequestObject
+
+	request.JobId = jobId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostJobsJobIdResume(ctx, request.(PostJobsJobIdResumeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostJobsJobIdResume")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostJobsJobIdResumeResponseObject); ok {
+		if err := validResponse.VisitPostJobsJobIdResumeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostJobsJobIdTrigger operation middleware
+func (sh *strictHandler) PostJobsJobIdTrigger(w http.ResponseWriter, r *http.Request, jobId string) {
+	var request PostJobsJobIdTriggerRequestObject
+
+	request.JobId = jobId
+
+	var body PostJobsJobIdTriggerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostJobsJobIdTrigger(ctx, request.(PostJobsJobIdTriggerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostJobsJobIdTrigger")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostJobsJobIdTriggerResponseObject); ok {
+		if err := validResponse.VisitPostJobsJobIdTriggerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostWorkersWorkerIdLease operation middleware
+func (sh *strictHandler) PostWorkersWorkerIdLease(w http.ResponseWriter, r *http.Request, workerId string, params PostWorkersWorkerIdLeaseParams) {
+	var request PostWorkersWorkerIdLeaseRequestObject
+
+	request.WorkerId = workerId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWorkersWorkerIdLease(ctx, request.(PostWorkersWorkerIdLeaseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWorkersWorkerIdLease")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostWorkersWorkerIdLeaseResponseObject); ok {
+		if err := validResponse.VisitPostWorkersWorkerIdLeaseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostWorkflows operation middleware
+func (sh *strictHandler) PostWorkflows(w http.ResponseWriter, r *http.Request) {
+	var request PostWorkflowsRequestObject
+
+	var body PostWorkflowsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWorkflows(ctx, request.(PostWorkflowsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWorkflows")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostWorkflowsResponseObject); ok {
+		if err := validResponse.VisitPostWorkflowsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWorkflowsWorkflowId operation middleware
+func (sh *strictHandler) GetWorkflowsWorkflowId(w http.ResponseWriter, r *http.Request, workflowId string) {
+	var request GetWorkflowsWorkflowIdRequestObject
+
+	request.WorkflowId = workflowId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWorkflowsWorkflowId(ctx, request.(GetWorkflowsWorkflowIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWorkflowsWorkflowId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWorkflowsWorkflowIdResponseObject); ok {
+		if err := validResponse.VisitGetWorkflowsWorkflowIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostWorkflowsWorkflowIdRuns operation middleware
+func (sh *strictHandler) PostWorkflowsWorkflowIdRuns(w http.ResponseWriter, r *http.Request, workflowId string) {
+	var request PostWorkflowsWorkflowIdRunsRequestObject
+
+	request.WorkflowId = workflowId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWorkflowsWorkflowIdRuns(ctx, request.(PostWorkflowsWorkflowIdRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWorkflowsWorkflowIdRuns")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostWorkflowsWorkflowIdRunsResponseObject); ok {
+		if err := validResponse.VisitPostWorkflowsWorkflowIdRunsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWorkflowsWorkflowIdRunsRunId operation middleware
+func (sh *strictHandler) GetWorkflowsWorkflowIdRunsRunId(w http.ResponseWriter, r *http.Request, workflowId string, runId string) {
+	var request GetWorkflowsWorkflowIdRunsRunIdRequestObject
+
+	request.WorkflowId = workflowId
+	request.RunId = runId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWorkflowsWorkflowIdRunsRunId(ctx, request.(GetWorkflowsWorkflowIdRunsRunIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWorkflowsWorkflowIdRunsRunId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWorkflowsWorkflowIdRunsRunIdResponseObject); ok {
+		if err := validResponse.VisitGetWorkflowsWorkflowIdRunsRunIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
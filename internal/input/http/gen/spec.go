@@ -18,18 +18,106 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8yWQW/bPAyG/4rB7zsasbsVw+Zbt3VFgx0K5FgUg2IzjTJZciUqXVD4vw+Ukjit3Sbp",
-	"imG3WJIpvg9fMn6A0tSN0ajJQfEArpxjLcLP819YepJG80NjTYOWJIatmdTSzbE6o/BkbC0ICpCaPpxC",
-	"CrRqMD7iLVpoU5AVH1yvO7JS3/Lywkwvh3ccCUtHXOBIkHeDoe6N/Yl28J52G8pMF1gSHx+baV9waVHQ",
-	"n+vlE3Yp1OCmEo6+HQvW6BIHozVipYzYTaOT2MH63+IMCvgv61yQrS2QTeIphmTxzkuLFRTXLG0bId0B",
-	"0xPQ5XAzjPlLeLcP+0VKrxA8VOXJFgFqX7OuO48eWZv1WnPMNLSGQgqrMyEV7krZeijUdWbCxZIU703K",
-	"OVZeoU3Ori4hhSVaFxoJTkb5KA86GtSikVDA+1E+Ogm0aB4yyhZmGn7cYrABsxHciOxhuEAa8z6/YEWN",
-	"hNZBcc3YoGAVdgUpaFHHJNd1ikU9uOQ3XHPXGO1iSd7leWgDowl1SEo0jZJlSCtbuDgkukskYb3XYNxp",
-	"XW2EtWIVcVboSiubOHtg4ssSnZt5lWxyCjV1vq6FXUEB36WjJEBjHxg3QO3KuA02NjM6+myq1VGa9khZ",
-	"u7l93C9kPbY9mCdHXTxguMeExmaarBuRCZzGYj0+c6mXQskqkbrx9IRfTD0RicZ75hi2gwuzh4WZ/pBV",
-	"GwNyM/TZfg3rTHcc5vmwM9nenTFjWHiKKn1Bdt+Tp32ZjCLmuUbxzBltKJkZr6snKKKWREQM6Yst+JfV",
-	"5m/p1oMb7RUMLzB0Y1IhCancgJsy3Hxb7J1zAfJ5d/ygsRf/8iPy5xGn/0qtDpqW3efYm8zMTZF2KhHi",
-	"OLTLDVpvFRQwJ2qKLFOmFGpuHBUf8085tDft7wAAAP//XGlPrDwKAAA=",
+	"H4sIAAAAAAAC/+w9a3PdtpV/BeV2JklLPWwnbqNOZla1ncSuXyu5451pUw8uee69sEiAAUBJdx39951z",
+	"AJAgifuypcTd2U++IkHg4LxfgD9khaobJUFak518yEyxhJrTz0dKFq3WIIvVa1WJYoUPS5jztrLZSTZX",
+	"eibKLM9KMIUWjRVKZifZ2yW3zCpWKna1BMnsEth7NWPCsLKFnCnNrBaLBWgoWc1ly6tqlbOrpaiAcdZo",
+	"uBSqNUy3Er8RkjVaLTQYc8J4VakrfGNw2poVAUKLUziAmLkQDb1nEmgw47JkGgqlS8OEZdwwTqMaKBlc",
+	"Q9Ei6DnT0FS8AFZwWUDlpiAoehBoKmO5tv0KSkLOfm6hhfEb/FjJAuhBtzElgc2FFGYJ5pCd0qgrLqyQ",
+	"C9wC40yD1StWqFZag9BGABz+U2Z5BrKts5N/ZISPLO9J4beQ5RkBlP2UZ3bVQHaSGauFXGQ3efYYePkc",
+	"rAWN9Gy0akBbAURybi3UjeME/6GQFhag8ctCA7dQnlp8PVe65tYNePh1lifGd7h9WjrWifnkOTeWzbmo",
+	"YiIwNQ9ozxKgizICrH/8Xs2ept+o1jatTb7SwI2SU7ieaK00KypuTICmSoE6hY8m/bkVGkokDhHEgTZE",
+	"Rd6jOcZpTys1ew+FRSCfdKutI9V0A6fuBZNtPQMdYTR3/El8plXN7qWJhvufzvp2uaJ5elI5hOTIoBoa",
+	"pS2UbLZiwhp2pfQFLq39cKVT1PRCsDs//Rrkn+5zoSxtylhuW5MzOFwcsgq4gXdw3SC1caMks0674ddD",
+	"JuIT7vlnkr+JPnsgxIGU3J/XsakNcsv8QpGsBZWC+r9sK8jyzCnnLM+QnHPUNCl14mi9hgDhy7M2qQPe",
+	"+tdOUyKewgdMqhK2CNt6aXmuFlOB2Vd7ldzy5KYM/Dzdyitd9sJWLFt5wa6EXQpnACu16LRJvKmdaKyB",
+	"17hgRyJbqtZm+KoErRNUGWkiBLibyG9sZ83zXC1OmwZkmcAobpN+CQs1/fi9hnl2kv3HUe9VHHmX4iie",
+	"8hF+iuvU/Pqp+/je8XGe1UKGPzuguNZ8tYXVRjvuRuYByG17dABNthiYoObXz0Eu7DI7efjNNw8eJoU3",
+	"EKr3kTpSfTTtCICNwL/mC5gC3lFkP9JkN1O0S7i2j1ptUmbhVcN/boEV9DpwOH7AGr6AnPGZAWmZkr0h",
+	"xRfbjSdBvXHfZ2AIyeOdrzFgj/u/ApyolFsNZJ553VS4UKGkhIJGaZi3BsqUoqY1HqF6ny70KHYd/BKs",
+	"5hYVK+MLLqSxzlw4r/qQfvNZBeR6mAE4VtTgGGYf42baogATG4aZUhVw+dFSFGbcSJHzzhwFdicvFL/X",
+	"rZS4RE7hRgWWnjqriJsTNZTv3Ead++2eey89aXh+BK7tDHiCBT5ii6mNdSusYzUH6pQF3gwcCLguAEoo",
+	"u1DIE5WCCWFYt3lyH4RlV86lQk+eXCqO0QToLpYRhnVI+gvN6rbBzFK1FQYoqsF5cKxUlgWUM2EPY8cj",
+	"YgryZ56QO2N2tJAjTI5mCHRMIvapvOSVKF9zzespViWvSaFFQqlV0l/qnbjNVKYZu+EpiJ6pWYK8qQB4",
+	"kxqdRswfETUVOrmlte4vfqoveZV8WfEZVFvV/3M3isYb+/2+fnktzFxo2A1HLwaDvXk5a+VpIpR5iYYE",
+	"R5OMMCHZ36W4ZrWoKmGgULI0fwkmZpBsuBJVRaxPH3O5qhXp+R02I5UVc1FwBCGh3d/CbKnURafg36tZ",
+	"jj+EZgYKDRixa2ASLoGCglZL0mQ7meGX0do/KnWRssZKFpAkdcNXleIxj/TsHZmbbTCcRUMHIcamj7zm",
+	"v8k7g5WCEN/9j5Jp8N2Dzcs8U7M3OCyKLDaGFRRSUCRBZLpaimLJltwwqVgU6OwQx3s0xNI8EZaeBNFW",
+	"x9KRJ7SKh2CNXnpEK96ddkpFwY802a1GgzGUG/uGKc0esrmAqjSMslQ1L7Ripi2WjBv2nyUXmISDS161",
+	"iCGU1oCFQ/ZG1GC6pBvZtJKvKrFYWmb4JeYkrObSCJdaQKntBLqoVHFh2Pu2bihJRwSguY0biSLhE2Od",
+	"yThm37I/sD+wF69eHnx/9jTL71hxfqIOHCudW1cXQ/qeff+IPXjw4FsmpLFcOs+Ds0oVvGIlt3BAChcj",
+	"WHRU1HxuwLIv7x/ff3hw/ODg/rdvju+fPDj+ihEOGw0Tgp/6yWiefehu1hCe96R3GVQcyl1qZi60sUwV",
+	"nr3hMJ1euRsNGWm8IZJf8GtRtzW6bYQF1EYy8gspD/2DYmWriYSH7DR+7V1mVim5AD1wFJ0X2Cfa8J2q",
+	"SmaVdwyZsMynHpbBiWUaTKOkmYjKvW/qFLZibT3c2NPTl6eOsPieSe42R6luhAx1Ss7+/uYREjwEw/GK",
+	"T1rUZEcvlCnUVXLtfYzBTVpv3kZcjG7hZx8RP1Ozc6XtMPMQ26khgN+jDkeD6DwVEzK3h+wZPnNGMulA",
+	"sSW/BCYV61w2R25VA+NzCxoLNEzZJWgqWnBTgCyRh5UuQQ9rFxsNabdAMvJDykPdVN4sDrf3TM0SqcQc",
+	"JWJk+Ql4KiZxwxquUc/1X+GLLB9xz51rkO1SeQuS8cbP0XOL0yOJWp7qqm3IMSdB4XTcQ1EfKXa4tqAl",
+	"r/wQk7OltU0/EoPXmcutBwJo1GFQzYNnZkCWbi1kfDCWOXBmbgV84QmQMwPAfnzz5vWZG3nIMOZ2KRGv",
+	"aGn5Xp0K1LbPzl+9ZA4XZNz8Yk4vdhl+ZCxZrF64ct9gyEyVq5xZ3crCeSEKk/twxf4m/npIq7kdU6iO",
+	"klHjFEqO9r1Uxro9YPLE74FC+RUhqxQGE0Ila2UFxuEkTOaKoviEyn+VMDaomW6BBDoItm3ogGthH5G4",
+	"BKP0wuTMJS197bMEPZLkjnkQ477WlZTb551PxcuSLD6vXg/ka/LJuGY4o8KsYgYqBJpwjarrb7DygRdH",
+	"t1DNWdvguIcPWEW1TpOzUiyENTn74uCLnH3x7gumNPvi8IucqcbBUq1Yo2EurqF06ODs8ctzZtpZqWou",
+	"XPKFM1Nxs/wLQ0cX3KJYb1vhdJVSF6wSFx6OkaX9kIG8zE6yRiuKEShdnM0wmo2FOxLW5yheiRTnsLCa",
+	"qojtaNmmeZ8RxvE9K4GXlZDpCDwn0ZelE9LO3TC7hNsjGzeskuIuJhCmDOCLse8dNSkIDe+ULOCdJE9j",
+	"fa8C0rtLNRhWC4OKrWwRp+i1qivy4tARbXhrIHjMcjHoWThhgyW9n4p/Mk1eL7/iK6roU6+BmjubSfJb",
+	"5+5jfBJ9N1eaAS+WYRT5v6RgnM2VZHFz8rJZqVXjuyOQW7GxwNAMnRuiYdFWXPeb9auK+TtXsTr5Z3t8",
+	"/KAIGoD+ghgg3JeYx50dqDHJu7esVsbSq/C90xvUlEGAXgkDh+zchwQRyslKUJsGlGiSJy0a1C7RfRA1",
+	"SHhijftNGlFcoA5tGHkvdslRKddCthYI5LEnPMLDveOagnprQcvsJPvXlwPa/hKI9QtC+svo48M/fvX7",
+	"lAGPg7bHUIlL0Ku7bsQo3Tp7fAGXIO0+8ecT+mDa9PHpPRzoHD4JNZ3kW5d9QruVRheyve+KWJtl9Ah3",
+	"rmPj3VaPt1VS7+1avg35s+GiviHAxUxVFdb39uR6yVvjaiTBynqYsoiafQUlZW1bXW3PjG9oUnEs4OaJ",
+	"EmA7965MuWMdEsjL6mNbDYxCJNJ7hH6XZHIL52SN3jmrHjDofDSrV6whIxB1vaAi6PA5dFyoqAXl+lLU",
+	"YKUkkicpmHUJY1ZwLNcEv+L1q/M3jtfIB0O3Mji7lBNZdj1ig+4op96IMtTKZtoZfQOGWeWcPppKGGbE",
+	"Qob1fnxx+ujg/MfT+988ZBewcpbapatznyS4RvcIjKVPEfvcsP8+OA8O5cG5WEhuWw0nzCz5/W8efuet",
+	"BH3lbQQnc0hLxt8S9YnK8dOg/A7ZI15V6GubK6KoR5HjOGcznO6+f33tjYTVoh8448WFms8dbUeO0mXo",
+	"a9w7odYptI0tCQ6JiQSblzEkmROTiS3IsystLLyS1So7sbqFXmZ7i4QutTk5OvJPDgtVH1H94ajz9bdm",
+	"DpwAe1SkJPW1VrMK6nSa8E9/Pv4Ta9wIVoLloprGxe75mvJUX+tL6EFfCuwyy/M4/svJ1QFJgg3aDfLt",
+	"nFTK27GuMqg3JvI5kz6qSIFbYavNFYvhhv5+9pRpmAMlIpkoQVoxXwWhDoikb7cRLgwiCDooUxQ8G+Ye",
+	"xryI4kK4HbehGacz5IFdev3pHEYhhRW8egwVX7E/sLqtrGgqAfpfX8qDe1/lnaNX82salDPTaOCkWN4L",
+	"a0MIip4YLmqiRlinoadqeZSoi0CY7ilKm+bsnlmXaLx3bFI+mINwOuv3mrvWj9nKpyTIkSUsCMM0l6Wq",
+	"qxXW2bUF6Tr/QvQT+wOlame+h45ywKQ7aiHd7+MOJNej6fugTiOfb+ShdK2cnZsgZFG1ZW8rKAHu602y",
+	"WzJRq/UE24hSVvCmCXOXjr73v16ux3IyVdVzTWIxra4w4OKF7bOkYaXhOgmkTnfYI3LcTbOpwZfMZmjd",
+	"5HLVtesgsYORwaCCAvx4z/+IOnMGvaDZT5FOWqMyguIZiXvMAikhD6bzb8I14wU3BiUri8pZvqiXclcw",
+	"T0ydisMomZsi7iqnvxBp6TlupcuHYui0T/Wmb11dm30dkpSyP65jJnCtVc1BBZdQocMTV+tDCtEHxcL0",
+	"2pAptMUTzKcSM6HE/OltpmviodAEM32hStjdowlwvlQlbGVACgbivLxb66cN+19XnL4D8P1Smz2y0Y62",
+	"b+ClKhPgl4DhlnklB5BuEed98m5r8JPuW8Jpt+1hHSEGOxlZFUSOt3N1a6I+Md/tN28xJTqDucueCHd2",
+	"xR1yGSpDuLZoOvdRfrtiq6v0RFgbeVxSYMUtarfuGtYHrZSaS4MCudXvomU2IfyslZ8u+Ld1/OHjJOqs",
+	"lWmdEHvDQcFv1OvrDgX03Tk7pCCiD9Y022wX5bCl9enyvdqg12ek1iq3dcmekE/qs7H4rxNNkIUAkwfX",
+	"fMkjv5iGOUfE5F0+tKvNUkkNCspG8366lZ9rkOvo00e7Wuv1vbdrtVRMvSmlbiganCtCnYuqOp9Gs9PX",
+	"2KRzCdo4nN07PD48RqSqBiRvRHaSPTg8PrznMrJLwvIRJmgOfIEHHyxcLI7E5yEHmf0Atj/zhmwVqnn0",
+	"yf3jY99NZX2OijdN5ZMAR+99f6njkJ2lrF8vYXQnZS0c3ZepUIFRbKWhoDSY0MZ5Iaata65XWJYRxrrK",
+	"rFPfIZFGBQHPPt4TEnqQGaOJBng7+hClud6J8sbxL/LDFJeP6XmEzv4nEb/BEBscOf7xIRPE/dwusyA1",
+	"2XCxLGYlq1vII1SP2e6nCem+TvX5d8hkbhcl0uDrbWOp2UG1shyh+rEwBdclCVg3egcsHmlwZzBRHSmT",
+	"4MvXytg1mDzz3/7qCL23lyyMUjmPg5vtVMzG41sTIeh0r/96X6Lh6G/T7SDChGaqEW3P2r5eRCczQkpx",
+	"rsGEjMisLRdgfdapVpfgY9URM3SbNUcfut/ECEG7buaEbv/mSZ+GfxQ+3YUR4lX3ZwNKuP1Vlau9OGAn",
+	"a+qPT9zc3IyhutlFonvO6O0Ukft4fTpRyKa1a1mon3E7Az2JWya81UVWysk4U+zvjXZ3FlRQFn2JucrZ",
+	"KtRnfV/MiAMDgUcnA7dw1HJw9GU/luqPzfw78lQP/U7cdHz7C8fMPOSUbgjjRQGNhTL3/NGlCP+vsO0Z",
+	"HfjGqoyNzx8Jw4xF79QvNuRqUqEOFT0Q21gdT85Gnt0ks91q357mznkyTmdVoWRGsTnXh+wtNXYobJX6",
+	"DplkMFgDc8c2XcfBOehLrHWBtOyJK7K5eymG+xAmWDilw1ZPfKmSZnbdVZVaMKq6sKulMsCE692yhhn4",
+	"2XVn0POSW05fSBafSM277jMHIqPuOGegJP4xmDxMMqgcdtPRPRMQzjbKBSsqgd9qMC21XpDj2GGGCcnw",
+	"fgZXuTt4+tgl6CdudVLBPEeK3aluyf10P7egV/18jshZPnBQfIpzzisD04NvN3mapSgTGHOKmzuwdH++",
+	"26VF3AHrFEyE2AFIk0A/URaI+qP8VpfAS9D9vAPqZPv5eHcQ78R8m0wzWri2R8SvB/056fVATzv/kgfo",
+	"70CdjtQcLzec37/JO+M7uoLD63/HRnTZRqQll6oqp8rRKRphQ+Pxjub87qXtrr3D/oKBj3UQiTmC4v8M",
+	"bOyextTtPmgaz2V9727CM8S2161GkdO5ApwBh/d9/vQr2BYqdT59fMhec2NYf64hLExTcBOOM1jFMBbq",
+	"egnp7iOrFuB2FzqqDa+hXyZq/p9YEDxysIZ9R5q0Swruxl7hROJUw2PDhUNJr8AvBMI5jw8FJmHoX+8I",
+	"RVyy2wiLT3Uybqmx1Ntjf+gnT3afpSAMGdPtZidlarYBF5cCPgquv9IEnwoYtYH7FnClc9cZf2AAuQjB",
+	"9CqkDg5cqG9gnx0q3xOsCX5H/ds5/vxd9JsJyb7k+ewr95dUdvgAGfp3F7Aat45a4PV3vpU7B3n5u+8a",
+	"rcrcCtDdlOu4ym8k+wifB4VsZ24MB4XWzkaSujtzd8XktRNWohY27ZG561669ojj4+PIFbq3CxsktFVo",
+	"xPYnqpKcSF/cqcu0hQZ0MC3h45x3VbfuvElkzNas7huK/rgfFKHjKwFFMJOEtrw738ujE23BmhnPTOPE",
+	"NJmnyDmaujJe7d+FX9GfmN7Jndgv4bnVT8V0o9d1vyHtMJ9ZwpxaqJQckchhx10qgiN7l+Low3s1260C",
+	"gAR85gs+231PN+3t5/qf0T435/hxzNrcPn3LuENDvrZ89Bvs9lY1zl7aZk8c/gDW85trDZ1yU5Td2cNp",
+	"Hbi9gwaeyJMN9+VN3Nk+VupufaCjq/3VdzufWr0D13ibR0y81m9hNwfZxRaO8/b3I/bzrsdXPq3zHiMC",
+	"Brx/ooMbSH5rDm4CxI93c/0MH+vm/r9PtpVvP2tVO7yT79/Pxat5hXyK2tFR5FMMQqT1EzZhcuPJVtv7",
+	"cvDFZ2qI9z5m0h0B3KFP5DZNtj9E5s8FBNM6oErub6iwYOIulBEhqZE3rkOOUqHxlSbxTdo+ZUZdy7pt",
+	"8BQA+69R30C4bbdrgaIT3sqGawdaaUUVn/h09YwyZVpD2EG89JqA/q1dV98DvSMJNzc4uLkYrzTwcjUi",
+	"+LlVDVIwZJ0RWw53wkZoS5HXvdpcZ+7QeuYG3xFeE7UaOkTtil7+AqbRAcDo3i8LeMrsauuR777iRzfJ",
+	"8YBb4qmU9Rpf8LWbfh7dAbU713TE+qQ8917MhQMDsw7Dfe/xupfutA8ilJppUuwUXUu9Az+FkwB3Kai3",
+	"n4EIUKc0OMiSfbhBBtRtfF2hXVJhWF1J1l8id7u5i7tv1vpVnZg3/W0wTF2C1qIEVvMLML1BcJIQeTjO",
+	"GHVXqSBf0yUqt6uF86gvoLuqK7quLzoC5//nhvVtaVJdddewFUsuFzgnztfVIkjK/KU7Rx+6KPDmqOou",
+	"D1kraW/dZ2/9LbjuupFdpC0ONj+5cL8pIhnGI1vCkV/Fe3NI2sFde+6clIE3QxfFhHsq3EEzqagsONGs",
+	"3Exl0/TUxo53s526btjdKLrRIaDfIt8aQPgMkq6BKFHm1buvg978YlVUMCJ2l5INc+R49dDpD33x1mcm",
+	"AucIPTg90DVGjtjj6EP4GbK666KsjlXexgcxdtMDfv7PJgoPW/jUEOptf4Hc+jgqonqU/1xDgSPq7dhN",
+	"ZntCnLU7xru3R4zb8SyS/4vPlCZo7jyDu5BTuoNp3uoNGJ1r2NYfvpVs57gW4+E/HRkcGNtKvaMPupUf",
+	"IU1IRPe/oNwlJdNZMgfxZyefeIrutkQUablJTF0bpbtQZHA5ZLhTX827O7aENY4FHXSGmkIdqeh+DrqU",
+	"4+ToiC64XSpjT/58/O1xdvPTzf8OAKEhB4tNbQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -3,54 +3,558 @@
 // Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
 package gen
 
+// Defines values for ConcurrencyPolicy.
+const (
+	Allow   ConcurrencyPolicy = "allow"
+	Forbid  ConcurrencyPolicy = "forbid"
+	Queue   ConcurrencyPolicy = "queue"
+	Replace ConcurrencyPolicy = "replace"
+)
+
+// Defines values for ExecutionTrigger.
+const (
+	ExecutionTriggerManual   ExecutionTrigger = "manual"
+	ExecutionTriggerSchedule ExecutionTrigger = "schedule"
+	ExecutionTriggerWorkflow ExecutionTrigger = "workflow"
+)
+
+// Defines values for ExecutionLogStream.
+const (
+	ExecutionLogStreamStderr ExecutionLogStream = "stderr"
+	ExecutionLogStreamStdout ExecutionLogStream = "stdout"
+)
+
+// Defines values for ExecutionLogChunkStream.
+const (
+	ExecutionLogChunkStreamStderr ExecutionLogChunkStream = "stderr"
+	ExecutionLogChunkStreamStdout ExecutionLogChunkStream = "stdout"
+)
+
+// Defines values for ExecutionStatus.
+const (
+	ExecutionStatusCancelled ExecutionStatus = "cancelled"
+	ExecutionStatusCompleted ExecutionStatus = "completed"
+	ExecutionStatusFailed    ExecutionStatus = "failed"
+	ExecutionStatusQueued    ExecutionStatus = "queued"
+	ExecutionStatusRunning   ExecutionStatus = "running"
+	ExecutionStatusSkipped   ExecutionStatus = "skipped"
+	ExecutionStatusTimedOut  ExecutionStatus = "timed_out"
+)
+
+// Defines values for JobSort.
+const (
+	CreatedAt      JobSort = "createdAt"
+	LastFinishedAt JobSort = "lastFinishedAt"
+	NextRunAt      JobSort = "nextRunAt"
+)
+
+// Defines values for JobType.
+const (
+	Exec   JobType = "exec"
+	Http   JobType = "http"
+	Worker JobType = "worker"
+)
+
+// Defines values for NotificationDeliveryStatus.
+const (
+	NotificationDeliveryStatusDelivered NotificationDeliveryStatus = "delivered"
+	NotificationDeliveryStatusFailed    NotificationDeliveryStatus = "failed"
+	NotificationDeliveryStatusPending   NotificationDeliveryStatus = "pending"
+)
+
+// Defines values for NotificationEvent.
+const (
+	NotificationEventDeadLettered NotificationEvent = "dead_lettered"
+	NotificationEventFailed       NotificationEvent = "failed"
+	NotificationEventSucceeded    NotificationEvent = "succeeded"
+	NotificationEventTimedOut     NotificationEvent = "timed_out"
+)
+
+// Defines values for ScheduleKind.
+const (
+	Cron     ScheduleKind = "cron"
+	Interval ScheduleKind = "interval"
+	Once     ScheduleKind = "once"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
 // Defines values for Status.
 const (
-	Completed Status = "completed"
-	Failed    Status = "failed"
-	Queued    Status = "queued"
-	Running   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+	StatusPaused    Status = "paused"
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusTimedOut  Status = "timed_out"
+)
+
+// Defines values for WorkflowRunStatus.
+const (
+	WorkflowRunStatusCompleted WorkflowRunStatus = "completed"
+	WorkflowRunStatusFailed    WorkflowRunStatus = "failed"
+	WorkflowRunStatusRunning   WorkflowRunStatus = "running"
 )
 
+// Defines values for WorkflowRunNodeStatus.
+const (
+	WorkflowRunNodeStatusCompleted WorkflowRunNodeStatus = "completed"
+	WorkflowRunNodeStatusFailed    WorkflowRunNodeStatus = "failed"
+	WorkflowRunNodeStatusPending   WorkflowRunNodeStatus = "pending"
+	WorkflowRunNodeStatusQueued    WorkflowRunNodeStatus = "queued"
+	WorkflowRunNodeStatusRunning   WorkflowRunNodeStatus = "running"
+	WorkflowRunNodeStatusSkipped   WorkflowRunNodeStatus = "skipped"
+)
+
+// ConcurrencyPolicy What to do when the job is due, or triggered manually, while a previous run is in progress: allow runs them concurrently, forbid skips the new run and records it as a skipped execution, replace cancels the run in progress and starts the new one, queue starts the new run once the previous one finishes. A run waiting for a retry counts as in progress.
+type ConcurrencyPolicy string
+
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	Attempts  int   `json:"attempts"`
+	CreatedAt int64 `json:"createdAt"`
+
+	// ExecutionId Last failed execution of the run
+	ExecutionId string  `json:"executionId"`
+	Id          string  `json:"id"`
+	JobId       string  `json:"jobId"`
+	Output      *string `json:"output,omitempty"`
+
+	// Reason Error class of the last failed execution
+	Reason *string `json:"reason,omitempty"`
+}
+
 // Execution defines model for Execution.
 type Execution struct {
+	// Attempt Attempt number of the run, starting from 1
+	Attempt *int `json:"attempt,omitempty"`
+
+	// Error Why the execution failed, as reported by its worker or executor
+	Error      *string `json:"error,omitempty"`
 	FinishedAt *int64  `json:"finishedAt,omitempty"`
 	Id         *string `json:"id,omitempty"`
 	JobId      *string `json:"jobId,omitempty"`
-	StartedAt  *int64  `json:"startedAt,omitempty"`
-	Status     *string `json:"status,omitempty"`
-	WorkerId   *string `json:"workerId,omitempty"`
+	Output     *string `json:"output,omitempty"`
+
+	// Reason Why the execution got its status, e.g. lease_expired or retry, or the error class of a failed execution
+	Reason    *string `json:"reason,omitempty"`
+	StartedAt *int64  `json:"startedAt,omitempty"`
+	Status    *string `json:"status,omitempty"`
+
+	// Trigger What started the run
+	Trigger  *ExecutionTrigger `json:"trigger,omitempty"`
+	WorkerId *string           `json:"workerId,omitempty"`
+
+	// WorkflowRunId Workflow run of a workflow node execution
+	WorkflowRunId *string `json:"workflowRunId,omitempty"`
+}
+
+// ExecutionTrigger What started the run
+type ExecutionTrigger string
+
+// ExecutionLog defines model for ExecutionLog.
+type ExecutionLog struct {
+	CreatedAt int64  `json:"createdAt"`
+	Data      string `json:"data"`
+
+	// Seq Order of the chunk within the logs of the execution
+	Seq    int64              `json:"seq"`
+	Stream ExecutionLogStream `json:"stream"`
+}
+
+// ExecutionLogStream defines model for ExecutionLog.Stream.
+type ExecutionLogStream string
+
+// ExecutionLogAppend defines model for ExecutionLogAppend.
+type ExecutionLogAppend struct {
+	Chunks   []ExecutionLogChunk `json:"chunks"`
+	WorkerId string              `json:"workerId"`
+}
+
+// ExecutionLogChunk defines model for ExecutionLogChunk.
+type ExecutionLogChunk struct {
+	Data   string                   `json:"data"`
+	Stream *ExecutionLogChunkStream `json:"stream,omitempty"`
+}
+
+// ExecutionLogChunkStream defines model for ExecutionLogChunk.Stream.
+type ExecutionLogChunkStream string
+
+// ExecutionPage defines model for ExecutionPage.
+type ExecutionPage struct {
+	Items []Execution `json:"items"`
+
+	// NextCursor Opaque cursor of the next page, absent on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// ExecutionResult defines model for ExecutionResult.
+type ExecutionResult struct {
+	// Error Description of the failure
+	Error *string `json:"error,omitempty"`
+
+	// ErrorClass Class of the failure matched against retryPolicy.retryableErrors
+	ErrorClass *string `json:"errorClass,omitempty"`
+	Output     *string `json:"output,omitempty"`
+	Success    bool    `json:"success"`
+	WorkerId   string  `json:"workerId"`
+}
+
+// ExecutionStatus defines model for ExecutionStatus.
+type ExecutionStatus string
+
+// Heartbeat defines model for Heartbeat.
+type Heartbeat struct {
+	WorkerId string `json:"workerId"`
+}
+
+// HeartbeatResult defines model for HeartbeatResult.
+type HeartbeatResult struct {
+	// Cancel The execution exceeded the job timeout and is timed_out, or it was replaced by a newer run and is cancelled; the worker should stop it and not complete it.
+	Cancel         bool  `json:"cancel"`
+	LeaseExpiresAt int64 `json:"leaseExpiresAt"`
+}
+
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
 }
 
 // Job defines model for Job.
 type Job struct {
-	CreatedAt      int64                  `json:"createdAt"`
-	Id             string                 `json:"id"`
-	Interval       *string                `json:"interval,omitempty"`
-	LastFinishedAt int64                  `json:"lastFinishedAt"`
-	Once           *string                `json:"once,omitempty"`
-	Payload        map[string]interface{} `json:"payload"`
-	Status         Status                 `json:"status"`
+	// ConcurrencyPolicy What to do when the job is due, or triggered manually, while a previous run is in progress: allow runs them concurrently, forbid skips the new run and records it as a skipped execution, replace cancels the run in progress and starts the new one, queue starts the new run once the previous one finishes. A run waiting for a retry counts as in progress.
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy"`
+	CreatedAt         int64             `json:"createdAt"`
+	Cron              *string           `json:"cron,omitempty"`
+	Id                string            `json:"id"`
+	Interval          *string           `json:"interval,omitempty"`
+
+	// Labels Labels to select jobs by. Keys are names of up to 63 letters, digits, '-', '_' or '.', optionally prefixed with a DNS subdomain and a slash; values are empty or look like names.
+	Labels         *Labels `json:"labels,omitempty"`
+	LastFinishedAt int64   `json:"lastFinishedAt"`
+
+	// MisfirePolicy What to do with fire times missed during a downtime, a pause or a long previous run: fire_once_now fires once right away in place of all of them, fire_all fires once for each of them one run after another, skip drops them and waits for the next regular fire time, fire_if_within:<duration> fires once now if the job is late by at most the duration and skips otherwise. Skipped fire times are recorded as skipped executions. A fire time counts as missed when the job is picked up more than a minute late.
+	MisfirePolicy MisfirePolicy `json:"misfirePolicy"`
+
+	// NextRunAt Next fire time in Unix milliseconds; absent when the job will not fire anymore
+	NextRunAt *int64 `json:"nextRunAt,omitempty"`
+
+	// Notifications Webhooks of the job, their secrets are never returned
+	Notifications *[]NotificationHook    `json:"notifications,omitempty"`
+	Once          *string                `json:"once,omitempty"`
+	Payload       map[string]interface{} `json:"payload"`
+
+	// RetryPolicy Retries of failed executions. The n-th retry waits initialDelay * multiplier^(n-1), at most maxDelay, spread by jitter. The job fails once the policy is exhausted.
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	Status      Status       `json:"status"`
+	Timeout     *string      `json:"timeout,omitempty"`
+	Timezone    string       `json:"timezone"`
+
+	// Type Who runs the job: worker jobs are leased by external workers, http jobs are run by the scheduler itself, which sends the request described by the payload, see HTTPRequest. The output of an http execution is a JSON object with the response status, latencyMs and the response body, truncated to a few KiB. exec jobs run a command on the scheduler host, see ExecRequest; they are disabled unless the command is in the allowlist of the scheduler. The output of an exec execution is a JSON object with exitCode, durationMs, stdout and stderr.
+	Type JobType `json:"type"`
+
+	// WorkflowId Workflow of a node job, which has no schedule
+	WorkflowId *string `json:"workflowId,omitempty"`
 }
 
 // JobCreate defines model for JobCreate.
 type JobCreate struct {
-	Interval *string                 `json:"interval,omitempty"`
-	Once     *string                 `json:"once,omitempty"`
-	Payload  *map[string]interface{} `json:"payload,omitempty"`
+	// ConcurrencyPolicy What to do when the job is due, or triggered manually, while a previous run is in progress: allow runs them concurrently, forbid skips the new run and records it as a skipped execution, replace cancels the run in progress and starts the new one, queue starts the new run once the previous one finishes. A run waiting for a retry counts as in progress.
+	ConcurrencyPolicy *ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Cron Cron expression, 5 or 6 fields or a macro such as @daily, evaluated in timezone. Times skipped by a daylight saving transition fire when the clocks jump, repeated times fire once.
+	Cron     *string `json:"cron,omitempty"`
+	Interval *string `json:"interval,omitempty"`
+
+	// Labels Labels to select jobs by. Keys are names of up to 63 letters, digits, '-', '_' or '.', optionally prefixed with a DNS subdomain and a slash; values are empty or look like names.
+	Labels *Labels `json:"labels,omitempty"`
+
+	// MisfirePolicy What to do with fire times missed during a downtime, a pause or a long previous run: fire_once_now fires once right away in place of all of them, fire_all fires once for each of them one run after another, skip drops them and waits for the next regular fire time, fire_if_within:<duration> fires once now if the job is late by at most the duration and skips otherwise. Skipped fire times are recorded as skipped executions. A fire time counts as missed when the job is picked up more than a minute late.
+	MisfirePolicy *MisfirePolicy      `json:"misfirePolicy,omitempty"`
+	Notifications *[]NotificationHook `json:"notifications,omitempty"`
+
+	// Once RFC 3339 instant, or a local date-time without offset (2026-03-29T02:30) interpreted in timezone. A local time skipped by a daylight saving transition fires when the clocks jump, a repeated one fires at its first occurrence.
+	Once    *string                 `json:"once,omitempty"`
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// RetryPolicy Retries of failed executions. The n-th retry waits initialDelay * multiplier^(n-1), at most maxDelay, spread by jitter. The job fails once the policy is exhausted.
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Timeout Maximum runtime of an execution as a Go duration. An execution running longer is timed_out and its worker is told to cancel it in the heartbeat response.
+	Timeout *string `json:"timeout,omitempty"`
+
+	// Timezone IANA time zone name of once and cron, UTC by default
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Type Who runs the job: worker jobs are leased by external workers, http jobs are run by the scheduler itself, which sends the request described by the payload, see HTTPRequest. The output of an http execution is a JSON object with the response status, latencyMs and the response body, truncated to a few KiB. exec jobs run a command on the scheduler host, see ExecRequest; they are disabled unless the command is in the allowlist of the scheduler. The output of an exec execution is a JSON object with exitCode, durationMs, stdout and stderr.
+	Type *JobType `json:"type,omitempty"`
 }
 
+// JobPage defines model for JobPage.
+type JobPage struct {
+	Items []Job `json:"items"`
+
+	// NextCursor Opaque cursor of the next page, absent on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// JobSort Field jobs are sorted by. Jobs which will not fire anymore have no nextRunAt and come after all others in ascending order.
+type JobSort string
+
+// JobTemplate Job of a workflow node, it has no schedule and runs as part of workflow runs
+type JobTemplate struct {
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+
+	// RetryPolicy Retries of failed executions. The n-th retry waits initialDelay * multiplier^(n-1), at most maxDelay, spread by jitter. The job fails once the policy is exhausted.
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	Timeout     *string      `json:"timeout,omitempty"`
+
+	// Type Who runs the job: worker jobs are leased by external workers, http jobs are run by the scheduler itself, which sends the request described by the payload, see HTTPRequest. The output of an http execution is a JSON object with the response status, latencyMs and the response body, truncated to a few KiB. exec jobs run a command on the scheduler host, see ExecRequest; they are disabled unless the command is in the allowlist of the scheduler. The output of an exec execution is a JSON object with exitCode, durationMs, stdout and stderr.
+	Type *JobType `json:"type,omitempty"`
+}
+
+// JobType Who runs the job: worker jobs are leased by external workers, http jobs are run by the scheduler itself, which sends the request described by the payload, see HTTPRequest. The output of an http execution is a JSON object with the response status, latencyMs and the response body, truncated to a few KiB. exec jobs run a command on the scheduler host, see ExecRequest; they are disabled unless the command is in the allowlist of the scheduler. The output of an exec execution is a JSON object with exitCode, durationMs, stdout and stderr.
+type JobType string
+
+// Labels Labels to select jobs by. Keys are names of up to 63 letters, digits, '-', '_' or '.', optionally prefixed with a DNS subdomain and a slash; values are empty or look like names.
+type Labels map[string]string
+
+// Lease defines model for Lease.
+type Lease struct {
+	ExecutionId string `json:"executionId"`
+	Job         Job    `json:"job"`
+
+	// LeaseExpiresAt Lease deadline in Unix milliseconds, extended by heartbeats
+	LeaseExpiresAt int64 `json:"leaseExpiresAt"`
+}
+
+// MisfirePolicy What to do with fire times missed during a downtime, a pause or a long previous run: fire_once_now fires once right away in place of all of them, fire_all fires once for each of them one run after another, skip drops them and waits for the next regular fire time, fire_if_within:<duration> fires once now if the job is late by at most the duration and skips otherwise. Skipped fire times are recorded as skipped executions. A fire time counts as missed when the job is picked up more than a minute late.
+type MisfirePolicy = string
+
+// NotificationDelivery defines model for NotificationDelivery.
+type NotificationDelivery struct {
+	Attempts    int    `json:"attempts"`
+	CreatedAt   int64  `json:"createdAt"`
+	DeliveredAt *int64 `json:"deliveredAt,omitempty"`
+
+	// Event failed and timed_out are sent for every such attempt, dead_lettered once the retry policy of the run is exhausted.
+	Event          NotificationEvent `json:"event"`
+	ExecutionId    string            `json:"executionId"`
+	Id             string            `json:"id"`
+	JobId          string            `json:"jobId"`
+	LastError      *string           `json:"lastError,omitempty"`
+	LastStatusCode *int              `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt Next attempt of a pending delivery in Unix milliseconds
+	NextAttemptAt *int64 `json:"nextAttemptAt,omitempty"`
+
+	// Status failed once all attempts are exhausted
+	Status NotificationDeliveryStatus `json:"status"`
+	Url    string                     `json:"url"`
+}
+
+// NotificationDeliveryStatus failed once all attempts are exhausted
+type NotificationDeliveryStatus string
+
+// NotificationEvent failed and timed_out are sent for every such attempt, dead_lettered once the retry policy of the run is exhausted.
+type NotificationEvent string
+
+// NotificationHook Webhook called with a POST of a JSON body describing the finished execution on the events it subscribes to. The body is signed with HMAC-SHA256 keyed by secret, the hex digest is sent as X-Scheduler-Signature: sha256=<digest> along with X-Scheduler-Event and X-Scheduler-Delivery. Calls answered with a status other than 2xx are retried with a backoff.
+type NotificationHook struct {
+	Events []NotificationEvent `json:"events"`
+
+	// Secret Required on create
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// Problem RFC 7807 problem details
+type Problem struct {
+	Detail *string `json:"detail,omitempty"`
+
+	// InvalidParams Invalid fields of the request, one entry per field and reason
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        int             `json:"status"`
+	Title         string          `json:"title"`
+
+	// Type URI reference identifying the problem type
+	Type string `json:"type"`
+}
+
+// RetryPolicy Retries of failed executions. The n-th retry waits initialDelay * multiplier^(n-1), at most maxDelay, spread by jitter. The job fails once the policy is exhausted.
+type RetryPolicy struct {
+	// InitialDelay Go duration, 1s by default
+	InitialDelay *string `json:"initialDelay,omitempty"`
+
+	// Jitter Fraction by which the delay is randomly shortened or extended
+	Jitter *float64 `json:"jitter,omitempty"`
+
+	// MaxAttempts Number of attempts including the first one
+	MaxAttempts int `json:"maxAttempts"`
+
+	// MaxDelay Go duration capping the delay, 24h by default
+	MaxDelay *string `json:"maxDelay,omitempty"`
+
+	// Multiplier Growth factor of the delay, 2 by default
+	Multiplier *float64 `json:"multiplier,omitempty"`
+
+	// RetryableErrors Error classes to retry, any failure is retried when empty
+	RetryableErrors *[]string `json:"retryableErrors,omitempty"`
+}
+
+// ScheduleKind defines model for ScheduleKind.
+type ScheduleKind string
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
 // Status defines model for Status.
 type Status string
 
+// Trigger defines model for Trigger.
+type Trigger struct {
+	// Payload Keys replacing the top-level keys of the job payload for this execution only
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
+// Workflow defines model for Workflow.
+type Workflow struct {
+	CreatedAt int64          `json:"createdAt"`
+	Id        string         `json:"id"`
+	Name      *string        `json:"name,omitempty"`
+	Nodes     []WorkflowNode `json:"nodes"`
+}
+
+// WorkflowCreate defines model for WorkflowCreate.
+type WorkflowCreate struct {
+	Name  *string              `json:"name,omitempty"`
+	Nodes []WorkflowNodeCreate `json:"nodes"`
+}
+
+// WorkflowNode defines model for WorkflowNode.
+type WorkflowNode struct {
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+	Job       Job       `json:"job"`
+	Name      string    `json:"name"`
+}
+
+// WorkflowNodeCreate defines model for WorkflowNodeCreate.
+type WorkflowNodeCreate struct {
+	// DependsOn Nodes which must complete successfully before this one starts
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
+	// Job Job of a workflow node, it has no schedule and runs as part of workflow runs
+	Job *JobTemplate `json:"job,omitempty"`
+
+	// Name Unique within the workflow
+	Name string `json:"name"`
+}
+
+// WorkflowRun defines model for WorkflowRun.
+type WorkflowRun struct {
+	CreatedAt  int64             `json:"createdAt"`
+	FinishedAt *int64            `json:"finishedAt,omitempty"`
+	Id         string            `json:"id"`
+	Nodes      []WorkflowRunNode `json:"nodes"`
+	Status     WorkflowRunStatus `json:"status"`
+	WorkflowId string            `json:"workflowId"`
+}
+
+// WorkflowRunStatus defines model for WorkflowRun.Status.
+type WorkflowRunStatus string
+
+// WorkflowRunNode defines model for WorkflowRunNode.
+type WorkflowRunNode struct {
+	Execution *Execution `json:"execution,omitempty"`
+	JobId     string     `json:"jobId"`
+	Name      string     `json:"name"`
+
+	// Status pending waits for its dependencies, failed has exhausted its retries, skipped will not run because a dependency failed
+	Status WorkflowRunNodeStatus `json:"status"`
+}
+
+// WorkflowRunNodeStatus pending waits for its dependencies, failed has exhausted its retries, skipped will not run because a dependency failed
+type WorkflowRunNodeStatus string
+
+// GetExecutionsExecutionIdLogsParams defines parameters for GetExecutionsExecutionIdLogs.
+type GetExecutionsExecutionIdLogsParams struct {
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+
+	// After Return only the chunks following the chunk with this seq
+	After       *int64  `form:"after,omitempty" json:"after,omitempty"`
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
 // GetJobsParams defines parameters for GetJobs.
 type GetJobsParams struct {
 	Status *Status `form:"status,omitempty" json:"status,omitempty"`
+
+	// Schedule Only jobs with this kind of schedule
+	Schedule *ScheduleKind `form:"schedule,omitempty" json:"schedule,omitempty"`
+
+	// CreatedAfter Only jobs created at or after this time, Unix milliseconds
+	CreatedAfter *int64 `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore Only jobs created before this time, Unix milliseconds
+	CreatedBefore *int64 `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+
+	// Selector Label selector, comma-separated requirements which must all hold: key=value, key!=value, key in (a,b), key notin (a,b), key and !key.
+	Selector *string    `form:"selector,omitempty" json:"selector,omitempty"`
+	Sort     *JobSort   `form:"sort,omitempty" json:"sort,omitempty"`
+	Order    *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit    *int       `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // GetJobsJobIdExecutionsParams defines parameters for GetJobsJobIdExecutions.
 type GetJobsJobIdExecutionsParams struct {
-	WorkerId *string `form:"worker_id,omitempty" json:"worker_id,omitempty"`
+	WorkerId *string          `form:"worker_id,omitempty" json:"worker_id,omitempty"`
+	Status   *ExecutionStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// StartedAfter Only executions started at or after this time, Unix milliseconds
+	StartedAfter *int64 `form:"startedAfter,omitempty" json:"startedAfter,omitempty"`
+
+	// StartedBefore Only executions started before this time, Unix milliseconds
+	StartedBefore *int64     `form:"startedBefore,omitempty" json:"startedBefore,omitempty"`
+	Order         *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit         *int       `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostJobsJobIdResumeParams defines parameters for PostJobsJobIdResume.
+type PostJobsJobIdResumeParams struct {
+	// MisfirePolicy Replaces the misfire policy of the job, which tells what to do with fire times missed while the job was paused
+	MisfirePolicy *MisfirePolicy `form:"misfirePolicy,omitempty" json:"misfirePolicy,omitempty"`
+}
+
+// PostWorkersWorkerIdLeaseParams defines parameters for PostWorkersWorkerIdLease.
+type PostWorkersWorkerIdLeaseParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// PostExecutionsExecutionIdCompleteJSONRequestBody defines body for PostExecutionsExecutionIdComplete for application/json ContentType.
+type PostExecutionsExecutionIdCompleteJSONRequestBody = ExecutionResult
+
+// PostExecutionsExecutionIdHeartbeatJSONRequestBody defines body for PostExecutionsExecutionIdHeartbeat for application/json ContentType.
+type PostExecutionsExecutionIdHeartbeatJSONRequestBody = Heartbeat
+
+// PostExecutionsExecutionIdLogsJSONRequestBody defines body for PostExecutionsExecutionIdLogs for application/json ContentType.
+type PostExecutionsExecutionIdLogsJSONRequestBody = ExecutionLogAppend
+
 // PostJobsJSONRequestBody defines body for PostJobs for application/json ContentType.
 type PostJobsJSONRequestBody = JobCreate
+
+// PostJobsJobIdTriggerJSONRequestBody defines body for PostJobsJobIdTrigger for application/json ContentType.
+type PostJobsJobIdTriggerJSONRequestBody = Trigger
+
+// PostWorkflowsJSONRequestBody defines body for PostWorkflows for application/json ContentType.
+type PostWorkflowsJSONRequestBody = WorkflowCreate
@@ -1,14 +1,27 @@
 package handler
 
 import (
+	"errors"
+	"net/http"
+	"scheduler/internal/cases"
 	"scheduler/internal/entity"
 	"scheduler/internal/input/http/gen"
 )
 
+// Типы ошибок валидации в теле RFC 7807
+const (
+	invalidJobProblemType      = "urn:scheduler:problem:invalid-job"
+	invalidWorkflowProblemType = "urn:scheduler:problem:invalid-workflow"
+	invalidQueryProblemType    = "urn:scheduler:problem:invalid-query"
+)
+
 // toEntityJob преобразует сгенерированную структуру в сущность для бизнес-логики
 func toEntityJob(j *gen.JobCreate) *entity.Job {
 	job := &entity.Job{
-		Payload: *j.Payload,
+		Payload: map[string]interface{}{},
+	}
+	if j.Payload != nil {
+		job.Payload = *j.Payload
 	}
 	if j.Once != nil {
 		job.Once = *j.Once
@@ -16,5 +29,367 @@ func toEntityJob(j *gen.JobCreate) *entity.Job {
 	if j.Interval != nil {
 		job.Interval = *j.Interval
 	}
+	if j.Cron != nil {
+		job.Cron = *j.Cron
+	}
+	if j.Timezone != nil {
+		job.Timezone = *j.Timezone
+	}
+	if j.RetryPolicy != nil {
+		job.RetryPolicy = toEntityRetryPolicy(j.RetryPolicy)
+	}
+	if j.Timeout != nil {
+		job.Timeout = *j.Timeout
+	}
+	if j.MisfirePolicy != nil {
+		job.MisfirePolicy = entity.MisfirePolicy(*j.MisfirePolicy)
+	}
+	if j.ConcurrencyPolicy != nil {
+		job.ConcurrencyPolicy = entity.ConcurrencyPolicy(*j.ConcurrencyPolicy)
+	}
+	if j.Type != nil {
+		job.Type = entity.JobType(*j.Type)
+	}
+	if j.Notifications != nil {
+		job.Notifications = toEntityNotifications(*j.Notifications)
+	}
+	if j.Labels != nil {
+		job.Labels = *j.Labels
+	}
 	return job
 }
+
+// toEntityNotifications преобразует вебхуки задания из тела запроса
+func toEntityNotifications(hooks []gen.NotificationHook) []entity.Notification {
+	res := make([]entity.Notification, 0, len(hooks))
+	for _, h := range hooks {
+		n := entity.Notification{URL: h.Url}
+		if h.Secret != nil {
+			n.Secret = *h.Secret
+		}
+		for _, e := range h.Events {
+			n.Events = append(n.Events, entity.NotificationEvent(e))
+		}
+		res = append(res, n)
+	}
+	return res
+}
+
+// toGenNotifications преобразует вебхуки задания в структуру ответа без секретов
+func toGenNotifications(hooks []entity.Notification) *[]gen.NotificationHook {
+	if len(hooks) == 0 {
+		return nil
+	}
+	res := make([]gen.NotificationHook, 0, len(hooks))
+	for _, h := range hooks {
+		events := make([]gen.NotificationEvent, 0, len(h.Events))
+		for _, e := range h.Events {
+			events = append(events, gen.NotificationEvent(e))
+		}
+		res = append(res, gen.NotificationHook{Url: h.URL, Events: events})
+	}
+	return &res
+}
+
+// toGenNotificationDelivery преобразует доставку уведомления в структуру ответа
+func toGenNotificationDelivery(d entity.NotificationDelivery) gen.NotificationDelivery {
+	res := gen.NotificationDelivery{
+		Id:          d.ID,
+		JobId:       d.JobID,
+		ExecutionId: d.ExecutionID,
+		Event:       gen.NotificationEvent(d.Event),
+		Url:         d.URL,
+		Status:      gen.NotificationDeliveryStatus(d.Status),
+		Attempts:    d.Attempts,
+		CreatedAt:   d.CreatedAt,
+	}
+	if d.LastStatusCode != 0 {
+		res.LastStatusCode = &d.LastStatusCode
+	}
+	if d.LastError != "" {
+		res.LastError = &d.LastError
+	}
+	if d.NextAttemptAt != 0 {
+		res.NextAttemptAt = &d.NextAttemptAt
+	}
+	if d.DeliveredAt != 0 {
+		res.DeliveredAt = &d.DeliveredAt
+	}
+	return res
+}
+
+// toEntityRetryPolicy преобразует политику повторов, незаданные поля остаются нулевыми
+func toEntityRetryPolicy(p *gen.RetryPolicy) *entity.RetryPolicy {
+	policy := &entity.RetryPolicy{
+		MaxAttempts: p.MaxAttempts,
+	}
+	if p.InitialDelay != nil {
+		policy.InitialDelay = *p.InitialDelay
+	}
+	if p.Multiplier != nil {
+		policy.Multiplier = *p.Multiplier
+	}
+	if p.MaxDelay != nil {
+		policy.MaxDelay = *p.MaxDelay
+	}
+	if p.Jitter != nil {
+		policy.Jitter = *p.Jitter
+	}
+	if p.RetryableErrors != nil {
+		policy.RetryableErrors = *p.RetryableErrors
+	}
+	return policy
+}
+
+// toGenRetryPolicy преобразует политику повторов в структуру ответа
+func toGenRetryPolicy(p *entity.RetryPolicy) *gen.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	res := &gen.RetryPolicy{
+		MaxAttempts: p.MaxAttempts,
+		Multiplier:  &p.Multiplier,
+		Jitter:      &p.Jitter,
+	}
+	if p.InitialDelay != "" {
+		res.InitialDelay = &p.InitialDelay
+	}
+	if p.MaxDelay != "" {
+		res.MaxDelay = &p.MaxDelay
+	}
+	if len(p.RetryableErrors) > 0 {
+		res.RetryableErrors = &p.RetryableErrors
+	}
+	return res
+}
+
+// toGenJob преобразует сущность в сгенерированную структуру ответа
+func toGenJob(job entity.Job) gen.Job {
+	res := gen.Job{
+		Id:                job.ID,
+		Type:              gen.JobType(job.Type),
+		Once:              &job.Once,
+		Interval:          &job.Interval,
+		Cron:              &job.Cron,
+		Status:            gen.Status(job.Status),
+		CreatedAt:         job.CreatedAt,
+		LastFinishedAt:    job.LastFinishedAt,
+		Payload:           job.Payload,
+		Timezone:          job.Timezone,
+		RetryPolicy:       toGenRetryPolicy(job.RetryPolicy),
+		MisfirePolicy:     gen.MisfirePolicy(job.MisfirePolicy),
+		ConcurrencyPolicy: gen.ConcurrencyPolicy(job.ConcurrencyPolicy),
+	}
+	if job.NextRunAt != 0 {
+		res.NextRunAt = &job.NextRunAt
+	}
+	if job.Timeout != "" {
+		res.Timeout = &job.Timeout
+	}
+	if job.WorkflowID != "" {
+		res.WorkflowId = &job.WorkflowID
+	}
+	res.Notifications = toGenNotifications(job.Notifications)
+	if len(job.Labels) > 0 {
+		labels := gen.Labels(job.Labels)
+		res.Labels = &labels
+	}
+	return res
+}
+
+// toGenExecution преобразует выполнение в сгенерированную структуру ответа
+func toGenExecution(exec entity.Execution) gen.Execution {
+	trigger := gen.ExecutionTrigger(exec.Trigger)
+	res := gen.Execution{
+		Id:         &exec.Id,
+		JobId:      &exec.JobId,
+		WorkerId:   &exec.WorkerId,
+		Status:     &exec.Status,
+		Attempt:    &exec.Attempt,
+		Trigger:    &trigger,
+		StartedAt:  &exec.StartedAt,
+		FinishedAt: &exec.FinishedAt,
+		Output:     &exec.Output,
+		Reason:     &exec.Reason,
+	}
+	if exec.WorkflowRunId != "" {
+		res.WorkflowRunId = &exec.WorkflowRunId
+	}
+	if exec.Error != "" {
+		res.Error = &exec.Error
+	}
+	return res
+}
+
+// toEntityExecutionLogs преобразует куски логов из тела запроса
+func toEntityExecutionLogs(chunks []gen.ExecutionLogChunk) []entity.ExecutionLog {
+	logs := make([]entity.ExecutionLog, 0, len(chunks))
+	for _, c := range chunks {
+		l := entity.ExecutionLog{Data: c.Data}
+		if c.Stream != nil {
+			l.Stream = string(*c.Stream)
+		}
+		logs = append(logs, l)
+	}
+	return logs
+}
+
+// toGenExecutionLog преобразует кусок логов в структуру ответа
+func toGenExecutionLog(l entity.ExecutionLog) gen.ExecutionLog {
+	return gen.ExecutionLog{
+		Seq:       l.Seq,
+		Stream:    gen.ExecutionLogStream(l.Stream),
+		Data:      l.Data,
+		CreatedAt: l.CreatedAt,
+	}
+}
+
+// toGenDeadLetter преобразует запись очереди недоставленных в структуру ответа
+func toGenDeadLetter(d entity.DeadLetter) gen.DeadLetter {
+	res := gen.DeadLetter{
+		Id:          d.Id,
+		JobId:       d.JobId,
+		ExecutionId: d.ExecutionId,
+		Attempts:    d.Attempts,
+		CreatedAt:   d.CreatedAt,
+	}
+	if d.Reason != "" {
+		res.Reason = &d.Reason
+	}
+	if d.Output != "" {
+		res.Output = &d.Output
+	}
+	return res
+}
+
+// toInvalidJobProblem преобразует ошибку валидации в тело application/problem+json
+func toInvalidJobProblem(err error) gen.Problem {
+	detail := err.Error()
+	problem := gen.Problem{
+		Type:   invalidJobProblemType,
+		Title:  "Invalid job definition",
+		Status: http.StatusBadRequest,
+		Detail: &detail,
+	}
+
+	// Перечисляем каждое невалидное поле отдельно
+	var verr *cases.ValidationError
+	if errors.As(err, &verr) {
+		params := make([]gen.InvalidParam, 0, len(verr.Fields))
+		for _, f := range verr.Fields {
+			params = append(params, gen.InvalidParam{Name: f.Field, Reason: f.Reason})
+		}
+		problem.InvalidParams = &params
+	}
+	return problem
+}
+
+// toInvalidWorkflowProblem описывает невалидный workflow так же, как невалидное задание
+func toInvalidWorkflowProblem(err error) gen.Problem {
+	problem := toInvalidJobProblem(err)
+	problem.Type = invalidWorkflowProblemType
+	problem.Title = "Invalid workflow definition"
+	return problem
+}
+
+// toInvalidQueryProblem описывает невалидный параметр списка, например чужой курсор
+func toInvalidQueryProblem(err error) gen.Problem {
+	detail := err.Error()
+	problem := gen.Problem{
+		Type:   invalidQueryProblemType,
+		Title:  "Invalid query",
+		Status: http.StatusBadRequest,
+		Detail: &detail,
+	}
+
+	var qerr *cases.QueryError
+	if errors.As(err, &qerr) {
+		problem.InvalidParams = &[]gen.InvalidParam{{Name: qerr.Param, Reason: qerr.Reason}}
+	}
+	return problem
+}
+
+// toEntityWorkflow преобразует описание workflow из запроса, задания узлов получают пустой payload по умолчанию
+func toEntityWorkflow(w *gen.WorkflowCreate) *entity.Workflow {
+	workflow := &entity.Workflow{
+		Nodes: make([]entity.WorkflowNode, 0, len(w.Nodes)),
+	}
+	if w.Name != nil {
+		workflow.Name = *w.Name
+	}
+	for _, n := range w.Nodes {
+		node := entity.WorkflowNode{
+			Name: n.Name,
+			Job:  entity.Job{Payload: map[string]interface{}{}},
+		}
+		if n.DependsOn != nil {
+			node.DependsOn = *n.DependsOn
+		}
+		if n.Job != nil {
+			if n.Job.Type != nil {
+				node.Job.Type = entity.JobType(*n.Job.Type)
+			}
+			if n.Job.Payload != nil {
+				node.Job.Payload = *n.Job.Payload
+			}
+			if n.Job.RetryPolicy != nil {
+				node.Job.RetryPolicy = toEntityRetryPolicy(n.Job.RetryPolicy)
+			}
+			if n.Job.Timeout != nil {
+				node.Job.Timeout = *n.Job.Timeout
+			}
+		}
+		workflow.Nodes = append(workflow.Nodes, node)
+	}
+	return workflow
+}
+
+// toGenWorkflow преобразует workflow вместе с заданиями узлов в структуру ответа
+func toGenWorkflow(w entity.Workflow) gen.Workflow {
+	res := gen.Workflow{
+		Id:        w.ID,
+		CreatedAt: w.CreatedAt,
+		Nodes:     make([]gen.WorkflowNode, 0, len(w.Nodes)),
+	}
+	if w.Name != "" {
+		res.Name = &w.Name
+	}
+	for _, n := range w.Nodes {
+		node := gen.WorkflowNode{
+			Name: n.Name,
+			Job:  toGenJob(n.Job),
+		}
+		if len(n.DependsOn) > 0 {
+			node.DependsOn = &n.DependsOn
+		}
+		res.Nodes = append(res.Nodes, node)
+	}
+	return res
+}
+
+// toGenWorkflowRun преобразует запуск workflow со статусами узлов в структуру ответа
+func toGenWorkflowRun(run entity.WorkflowRun) gen.WorkflowRun {
+	res := gen.WorkflowRun{
+		Id:         run.ID,
+		WorkflowId: run.WorkflowID,
+		Status:     gen.WorkflowRunStatus(run.Status),
+		CreatedAt:  run.CreatedAt,
+		Nodes:      make([]gen.WorkflowRunNode, 0, len(run.Nodes)),
+	}
+	if run.FinishedAt != 0 {
+		res.FinishedAt = &run.FinishedAt
+	}
+	for _, n := range run.Nodes {
+		node := gen.WorkflowRunNode{
+			Name:   n.Name,
+			JobId:  n.JobID,
+			Status: gen.WorkflowRunNodeStatus(n.Status),
+		}
+		if n.Execution != nil {
+			exec := toGenExecution(*n.Execution)
+			node.Execution = &exec
+		}
+		res.Nodes = append(res.Nodes, node)
+	}
+	return res
+}
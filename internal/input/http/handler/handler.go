@@ -2,9 +2,11 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"scheduler/internal/cases"
 	"scheduler/internal/entity"
 	"scheduler/internal/input/http/gen"
+	"strconv"
 )
 
 var _ gen.StrictServerInterface = (*Handler)(nil)
@@ -13,8 +15,25 @@ type JobsCases interface {
 	Create(ctx context.Context, job *entity.Job) (string, error)
 	GetOneByID(ctx context.Context, jobID string) (entity.Job, error)
 	Delete(ctx context.Context, jobID string) error
-	List(ctx context.Context, status *string) ([]entity.Job, error)
-	ListExecutions(ctx context.Context, jobID string, workerID *string) ([]entity.Execution, error)
+	List(ctx context.Context, query entity.JobsQuery) (entity.JobsPage, error)
+	ListExecutions(ctx context.Context, jobID string, query entity.ExecutionsQuery) (entity.ExecutionsPage, error)
+	ListNotifications(ctx context.Context, jobID string) ([]entity.NotificationDelivery, error)
+	Trigger(ctx context.Context, jobID string, payload map[string]any) (string, error)
+	Pause(ctx context.Context, jobID string) error
+	Resume(ctx context.Context, jobID string, policy entity.MisfirePolicy) error
+	Lease(ctx context.Context, workerID string, limit int) ([]entity.Lease, error)
+	Heartbeat(ctx context.Context, execID string, workerID string) (int64, bool, error)
+	Complete(ctx context.Context, execID string, workerID string, result entity.ExecutionResult) error
+	ListDeadLetters(ctx context.Context) ([]entity.DeadLetter, error)
+	RequeueDeadLetter(ctx context.Context, deadLetterID string) (string, error)
+	DeleteDeadLetter(ctx context.Context, deadLetterID string) error
+	CreateWorkflow(ctx context.Context, workflow *entity.Workflow) (string, error)
+	GetWorkflow(ctx context.Context, workflowID string) (entity.Workflow, error)
+	StartWorkflowRun(ctx context.Context, workflowID string) (string, error)
+	GetWorkflowRun(ctx context.Context, workflowID string, runID string) (entity.WorkflowRun, error)
+	AppendLogs(ctx context.Context, execID string, workerID string, logs []entity.ExecutionLog) error
+	ExecutionLogs(ctx context.Context, execID string, after int64) ([]entity.ExecutionLog, error)
+	FollowExecutionLogs(ctx context.Context, execID string, after int64, send func(entity.ExecutionLog) error) (entity.Execution, error)
 }
 
 type Handler struct {
@@ -31,10 +50,13 @@ func NewHandler(schCase JobsCases) *Handler {
 // (POST /jobs)
 func (r *Handler) PostJobs(ctx context.Context, request gen.PostJobsRequestObject) (gen.PostJobsResponseObject, error) {
 	if request.Body == nil {
-		return gen.PostJobs400Response{}, nil
+		return gen.PostJobs400ApplicationProblemPlusJSONResponse(toInvalidJobProblem(errors.New("request body is required"))), nil
 	}
 	jobID, err := r.schedulerCase.Create(ctx, toEntityJob(request.Body))
 	if err != nil {
+		if errors.Is(err, cases.ErrInvalidJob) {
+			return gen.PostJobs400ApplicationProblemPlusJSONResponse(toInvalidJobProblem(err)), nil
+		}
 		return nil, err // 500
 	}
 	return gen.PostJobs201JSONResponse(jobID), nil
@@ -43,31 +65,51 @@ func (r *Handler) PostJobs(ctx context.Context, request gen.PostJobsRequestObjec
 // List jobs
 // (GET /jobs)
 func (r *Handler) GetJobs(ctx context.Context, request gen.GetJobsRequestObject) (gen.GetJobsResponseObject, error) {
+	params := request.Params
+	query := entity.JobsQuery{
+		CreatedAfter:  params.CreatedAfter,
+		CreatedBefore: params.CreatedBefore,
+	}
 	// Извлекаем параметр status, если он есть
-	var status *string
-	if request.Params.Status != nil {
-		s := string(*request.Params.Status)
-		status = &s
+	if params.Status != nil {
+		s := string(*params.Status)
+		query.Status = &s
+	}
+	if params.Schedule != nil {
+		query.Schedule = string(*params.Schedule)
+	}
+	if params.Selector != nil {
+		query.Selector = *params.Selector
+	}
+	if params.Sort != nil {
+		query.Sort = string(*params.Sort)
+	}
+	if params.Order != nil {
+		query.Order = string(*params.Order)
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
 	}
 
-	// Получаем список заданий с фильтрацией по статусу
-	jobs, err := r.schedulerCase.List(ctx, status)
+	// Получаем страницу заданий с фильтрами
+	page, err := r.schedulerCase.List(ctx, query)
 	if err != nil {
+		if errors.Is(err, cases.ErrInvalidQuery) {
+			return gen.GetJobs400ApplicationProblemPlusJSONResponse(toInvalidQueryProblem(err)), nil
+		}
 		return nil, err // 500
 	}
 
 	// Преобразуем сущности в сгенерированные структуры
-	response := make([]gen.Job, len(jobs))
-	for i, job := range jobs {
-		response[i] = gen.Job{
-			Id:             job.ID,
-			Once:           &job.Once,
-			Interval:       &job.Interval,
-			Status:         gen.Status(job.Status),
-			CreatedAt:      job.CreatedAt,
-			LastFinishedAt: job.LastFinishedAt,
-			Payload:        job.Payload,
-		}
+	response := gen.JobPage{Items: make([]gen.Job, len(page.Jobs))}
+	for i, job := range page.Jobs {
+		response.Items[i] = toGenJob(job)
+	}
+	if page.NextCursor != "" {
+		response.NextCursor = &page.NextCursor
 	}
 
 	return gen.GetJobs200JSONResponse(response), nil
@@ -97,49 +139,369 @@ func (r *Handler) GetJobsJobId(ctx context.Context, request gen.GetJobsJobIdRequ
 		return nil, err // 500
 	}
 
-	response := gen.Job{
-		Id:             job.ID,
-		Once:           &job.Once,
-		Interval:       &job.Interval,
-		Status:         gen.Status(job.Status),
-		CreatedAt:      job.CreatedAt,
-		LastFinishedAt: job.LastFinishedAt,
-		Payload:        job.Payload,
-	}
-
-	return gen.GetJobsJobId200JSONResponse(response), nil
+	return gen.GetJobsJobId200JSONResponse(toGenJob(job)), nil
 }
 
 // Get job executions
 // (GET /jobs/{job_id}/executions)
 func (r *Handler) GetJobsJobIdExecutions(ctx context.Context, request gen.GetJobsJobIdExecutionsRequestObject) (gen.GetJobsJobIdExecutionsResponseObject, error) {
-	// Извлекаем параметр worker_id, если он есть
-	var workerID *string
-	if request.Params.WorkerId != nil {
-		workerID = request.Params.WorkerId
+	params := request.Params
+	query := entity.ExecutionsQuery{
+		WorkerID:      params.WorkerId,
+		StartedAfter:  params.StartedAfter,
+		StartedBefore: params.StartedBefore,
+	}
+	if params.Status != nil {
+		s := string(*params.Status)
+		query.Status = &s
+	}
+	if params.Order != nil {
+		query.Order = string(*params.Order)
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
 	}
 
-	// Получаем выполнения задания
-	executions, err := r.schedulerCase.ListExecutions(ctx, request.JobId, workerID)
+	// Получаем страницу выполнений задания
+	page, err := r.schedulerCase.ListExecutions(ctx, request.JobId, query)
 	if err != nil {
 		if err == cases.ErrNotFound {
 			return gen.GetJobsJobIdExecutions404Response{}, nil
 		}
+		if errors.Is(err, cases.ErrInvalidQuery) {
+			return gen.GetJobsJobIdExecutions400ApplicationProblemPlusJSONResponse(toInvalidQueryProblem(err)), nil
+		}
 		return nil, err // 500
 	}
 
 	// Преобразуем сущности в сгенерированные структуры
-	response := make([]gen.Execution, len(executions))
-	for i, exec := range executions {
-		response[i] = gen.Execution{
-			Id:         &exec.Id,
-			JobId:      &exec.JobId,
-			WorkerId:   &exec.WorkerId,
-			Status:     &exec.Status,
-			StartedAt:  &exec.StartedAt,
-			FinishedAt: &exec.FinishedAt,
-		}
+	response := gen.ExecutionPage{Items: make([]gen.Execution, len(page.Executions))}
+	for i, exec := range page.Executions {
+		response.Items[i] = toGenExecution(exec)
+	}
+	if page.NextCursor != "" {
+		response.NextCursor = &page.NextCursor
 	}
 
 	return gen.GetJobsJobIdExecutions200JSONResponse(response), nil
 }
+
+// Get deliveries of the job notifications, the latest first
+// (GET /jobs/{job_id}/notifications)
+func (r *Handler) GetJobsJobIdNotifications(ctx context.Context, request gen.GetJobsJobIdNotificationsRequestObject) (gen.GetJobsJobIdNotificationsResponseObject, error) {
+	deliveries, err := r.schedulerCase.ListNotifications(ctx, request.JobId)
+	if err != nil {
+		if errors.Is(err, cases.ErrNotFound) {
+			return gen.GetJobsJobIdNotifications404Response{}, nil
+		}
+		return nil, err // 500
+	}
+
+	response := make([]gen.NotificationDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		response = append(response, toGenNotificationDelivery(d))
+	}
+	return gen.GetJobsJobIdNotifications200JSONResponse(response), nil
+}
+
+// Run the job now without changing its schedule
+// (POST /jobs/{job_id}/trigger)
+func (r *Handler) PostJobsJobIdTrigger(ctx context.Context, request gen.PostJobsJobIdTriggerRequestObject) (gen.PostJobsJobIdTriggerResponseObject, error) {
+	// Без payload выполнение получает payload задания
+	var payload map[string]any
+	if request.Body != nil && request.Body.Payload != nil {
+		payload = *request.Body.Payload
+	}
+
+	execID, err := r.schedulerCase.Trigger(ctx, request.JobId, payload)
+	if err != nil {
+		switch {
+		case errors.Is(err, cases.ErrInvalidJob):
+			return gen.PostJobsJobIdTrigger400ApplicationProblemPlusJSONResponse(toInvalidJobProblem(err)), nil
+		case errors.Is(err, cases.ErrNotFound):
+			return gen.PostJobsJobIdTrigger404Response{}, nil
+		case errors.Is(err, cases.ErrConflict):
+			return gen.PostJobsJobIdTrigger409Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.PostJobsJobIdTrigger201JSONResponse(execID), nil
+}
+
+// Stop firing the job until it is resumed
+// (POST /jobs/{job_id}/pause)
+func (r *Handler) PostJobsJobIdPause(ctx context.Context, request gen.PostJobsJobIdPauseRequestObject) (gen.PostJobsJobIdPauseResponseObject, error) {
+	if err := r.schedulerCase.Pause(ctx, request.JobId); err != nil {
+		switch {
+		case errors.Is(err, cases.ErrNotFound):
+			return gen.PostJobsJobIdPause404Response{}, nil
+		case errors.Is(err, cases.ErrConflict):
+			return gen.PostJobsJobIdPause409Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.PostJobsJobIdPause204Response{}, nil
+}
+
+// Let the paused job fire again
+// (POST /jobs/{job_id}/resume)
+func (r *Handler) PostJobsJobIdResume(ctx context.Context, request gen.PostJobsJobIdResumeRequestObject) (gen.PostJobsJobIdResumeResponseObject, error) {
+	// Без параметра политика задания не меняется
+	var policy entity.MisfirePolicy
+	if request.Params.MisfirePolicy != nil {
+		var err error
+		if policy, err = entity.ParseMisfirePolicy(string(*request.Params.MisfirePolicy)); err != nil {
+			return gen.PostJobsJobIdResume400Response{}, nil
+		}
+	}
+
+	if err := r.schedulerCase.Resume(ctx, request.JobId, policy); err != nil {
+		switch {
+		case errors.Is(err, cases.ErrNotFound):
+			return gen.PostJobsJobIdResume404Response{}, nil
+		case errors.Is(err, cases.ErrConflict):
+			return gen.PostJobsJobIdResume409Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.PostJobsJobIdResume204Response{}, nil
+}
+
+// Lease queued executions
+// (POST /workers/{worker_id}/lease)
+func (r *Handler) PostWorkersWorkerIdLease(ctx context.Context, request gen.PostWorkersWorkerIdLeaseRequestObject) (gen.PostWorkersWorkerIdLeaseResponseObject, error) {
+	limit := 1
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	leases, err := r.schedulerCase.Lease(ctx, request.WorkerId, limit)
+	if err != nil {
+		return nil, err // 500
+	}
+
+	response := make([]gen.Lease, len(leases))
+	for i, lease := range leases {
+		response[i] = gen.Lease{
+			ExecutionId:    lease.Execution.Id,
+			Job:            toGenJob(lease.Job),
+			LeaseExpiresAt: lease.LeaseExpiresAt,
+		}
+	}
+
+	return gen.PostWorkersWorkerIdLease200JSONResponse(response), nil
+}
+
+// Report that the worker is still running the execution and extend its lease
+// (POST /executions/{execution_id}/heartbeat)
+func (r *Handler) PostExecutionsExecutionIdHeartbeat(ctx context.Context, request gen.PostExecutionsExecutionIdHeartbeatRequestObject) (gen.PostExecutionsExecutionIdHeartbeatResponseObject, error) {
+	if request.Body == nil {
+		return gen.PostExecutionsExecutionIdHeartbeat400Response{}, nil
+	}
+	leaseExpiresAt, cancel, err := r.schedulerCase.Heartbeat(ctx, request.ExecutionId, request.Body.WorkerId)
+	if err != nil {
+		switch {
+		case errors.Is(err, cases.ErrInvalidJob):
+			return gen.PostExecutionsExecutionIdHeartbeat400Response{}, nil
+		case errors.Is(err, cases.ErrNotFound):
+			return gen.PostExecutionsExecutionIdHeartbeat404Response{}, nil
+		case errors.Is(err, cases.ErrConflict):
+			return gen.PostExecutionsExecutionIdHeartbeat409Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.PostExecutionsExecutionIdHeartbeat200JSONResponse{LeaseExpiresAt: leaseExpiresAt, Cancel: cancel}, nil
+}
+
+// Complete the execution
+// (POST /executions/{execution_id}/complete)
+func (r *Handler) PostExecutionsExecutionIdComplete(ctx context.Context, request gen.PostExecutionsExecutionIdCompleteRequestObject) (gen.PostExecutionsExecutionIdCompleteResponseObject, error) {
+	if request.Body == nil {
+		return gen.PostExecutionsExecutionIdComplete400Response{}, nil
+	}
+	result := entity.ExecutionResult{Success: request.Body.Success}
+	if request.Body.Output != nil {
+		result.Output = *request.Body.Output
+	}
+	if request.Body.ErrorClass != nil {
+		result.ErrorClass = *request.Body.ErrorClass
+	}
+	if request.Body.Error != nil {
+		result.Error = *request.Body.Error
+	}
+
+	err := r.schedulerCase.Complete(ctx, request.ExecutionId, request.Body.WorkerId, result)
+	if err != nil {
+		switch {
+		case errors.Is(err, cases.ErrInvalidJob):
+			return gen.PostExecutionsExecutionIdComplete400Response{}, nil
+		case errors.Is(err, cases.ErrNotFound):
+			return gen.PostExecutionsExecutionIdComplete404Response{}, nil
+		case errors.Is(err, cases.ErrConflict):
+			return gen.PostExecutionsExecutionIdComplete409Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.PostExecutionsExecutionIdComplete204Response{}, nil
+}
+
+// Append chunks of the output of the execution
+// (POST /executions/{execution_id}/logs)
+func (r *Handler) PostExecutionsExecutionIdLogs(ctx context.Context, request gen.PostExecutionsExecutionIdLogsRequestObject) (gen.PostExecutionsExecutionIdLogsResponseObject, error) {
+	if request.Body == nil {
+		return gen.PostExecutionsExecutionIdLogs400Response{}, nil
+	}
+	err := r.schedulerCase.AppendLogs(ctx, request.ExecutionId, request.Body.WorkerId, toEntityExecutionLogs(request.Body.Chunks))
+	if err != nil {
+		switch {
+		case errors.Is(err, cases.ErrInvalidJob):
+			return gen.PostExecutionsExecutionIdLogs400Response{}, nil
+		case errors.Is(err, cases.ErrNotFound):
+			return gen.PostExecutionsExecutionIdLogs404Response{}, nil
+		case errors.Is(err, cases.ErrConflict):
+			return gen.PostExecutionsExecutionIdLogs409Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.PostExecutionsExecutionIdLogs204Response{}, nil
+}
+
+// Read the logs of the execution
+// (GET /executions/{execution_id}/logs)
+func (r *Handler) GetExecutionsExecutionIdLogs(ctx context.Context, request gen.GetExecutionsExecutionIdLogsRequestObject) (gen.GetExecutionsExecutionIdLogsResponseObject, error) {
+	var after int64
+	if request.Params.After != nil {
+		after = *request.Params.After
+	}
+	// Переподключившийся клиент SSE продолжает с последнего полученного события
+	if request.Params.LastEventID != nil {
+		id, err := strconv.ParseInt(*request.Params.LastEventID, 10, 64)
+		if err != nil || id < 0 {
+			return gen.GetExecutionsExecutionIdLogs400Response{}, nil
+		}
+		after = id
+	}
+
+	logs, err := r.schedulerCase.ExecutionLogs(ctx, request.ExecutionId, after)
+	if err != nil {
+		switch {
+		case errors.Is(err, cases.ErrInvalidJob):
+			return gen.GetExecutionsExecutionIdLogs400Response{}, nil
+		case errors.Is(err, cases.ErrNotFound):
+			return gen.GetExecutionsExecutionIdLogs404Response{}, nil
+		}
+		return nil, err // 500
+	}
+
+	if request.Params.Follow != nil && *request.Params.Follow {
+		return &logStream{ctx: ctx, cases: r.schedulerCase, execID: request.ExecutionId, after: after, backlog: logs}, nil
+	}
+
+	response := make([]gen.ExecutionLog, 0, len(logs))
+	for _, l := range logs {
+		response = append(response, toGenExecutionLog(l))
+	}
+	return gen.GetExecutionsExecutionIdLogs200JSONResponse(response), nil
+}
+
+// List runs which failed after exhausting their retry policy
+// (GET /dead-letters)
+func (r *Handler) GetDeadLetters(ctx context.Context, request gen.GetDeadLettersRequestObject) (gen.GetDeadLettersResponseObject, error) {
+	deadLetters, err := r.schedulerCase.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, err // 500
+	}
+
+	response := make([]gen.DeadLetter, len(deadLetters))
+	for i, d := range deadLetters {
+		response[i] = toGenDeadLetter(d)
+	}
+
+	return gen.GetDeadLetters200JSONResponse(response), nil
+}
+
+// Run the job again with a fresh retry budget and remove the dead letter
+// (POST /dead-letters/{dead_letter_id}/requeue)
+func (r *Handler) PostDeadLettersDeadLetterIdRequeue(ctx context.Context, request gen.PostDeadLettersDeadLetterIdRequeueRequestObject) (gen.PostDeadLettersDeadLetterIdRequeueResponseObject, error) {
+	execID, err := r.schedulerCase.RequeueDeadLetter(ctx, request.DeadLetterId)
+	if err != nil {
+		switch {
+		case errors.Is(err, cases.ErrNotFound):
+			return gen.PostDeadLettersDeadLetterIdRequeue404Response{}, nil
+		case errors.Is(err, cases.ErrConflict):
+			return gen.PostDeadLettersDeadLetterIdRequeue409Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.PostDeadLettersDeadLetterIdRequeue201JSONResponse(execID), nil
+}
+
+// Discard a dead letter
+// (DELETE /dead-letters/{dead_letter_id})
+func (r *Handler) DeleteDeadLettersDeadLetterId(ctx context.Context, request gen.DeleteDeadLettersDeadLetterIdRequestObject) (gen.DeleteDeadLettersDeadLetterIdResponseObject, error) {
+	if err := r.schedulerCase.DeleteDeadLetter(ctx, request.DeadLetterId); err != nil {
+		if errors.Is(err, cases.ErrNotFound) {
+			return gen.DeleteDeadLettersDeadLetterId404Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.DeleteDeadLettersDeadLetterId204Response{}, nil
+}
+
+// Create a workflow, a DAG of jobs started when their dependencies complete
+// (POST /workflows)
+func (r *Handler) PostWorkflows(ctx context.Context, request gen.PostWorkflowsRequestObject) (gen.PostWorkflowsResponseObject, error) {
+	if request.Body == nil {
+		return gen.PostWorkflows400ApplicationProblemPlusJSONResponse(toInvalidWorkflowProblem(errors.New("request body is required"))), nil
+	}
+	workflowID, err := r.schedulerCase.CreateWorkflow(ctx, toEntityWorkflow(request.Body))
+	if err != nil {
+		if errors.Is(err, cases.ErrInvalidJob) {
+			return gen.PostWorkflows400ApplicationProblemPlusJSONResponse(toInvalidWorkflowProblem(err)), nil
+		}
+		return nil, err // 500
+	}
+	return gen.PostWorkflows201JSONResponse(workflowID), nil
+}
+
+// Get workflow details
+// (GET /workflows/{workflow_id})
+func (r *Handler) GetWorkflowsWorkflowId(ctx context.Context, request gen.GetWorkflowsWorkflowIdRequestObject) (gen.GetWorkflowsWorkflowIdResponseObject, error) {
+	workflow, err := r.schedulerCase.GetWorkflow(ctx, request.WorkflowId)
+	if err != nil {
+		if errors.Is(err, cases.ErrNotFound) {
+			return gen.GetWorkflowsWorkflowId404Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.GetWorkflowsWorkflowId200JSONResponse(toGenWorkflow(workflow)), nil
+}
+
+// Start a run of the workflow
+// (POST /workflows/{workflow_id}/runs)
+func (r *Handler) PostWorkflowsWorkflowIdRuns(ctx context.Context, request gen.PostWorkflowsWorkflowIdRunsRequestObject) (gen.PostWorkflowsWorkflowIdRunsResponseObject, error) {
+	runID, err := r.schedulerCase.StartWorkflowRun(ctx, request.WorkflowId)
+	if err != nil {
+		if errors.Is(err, cases.ErrNotFound) {
+			return gen.PostWorkflowsWorkflowIdRuns404Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.PostWorkflowsWorkflowIdRuns201JSONResponse(runID), nil
+}
+
+// Get the status of a workflow run and of each of its nodes
+// (GET /workflows/{workflow_id}/runs/{run_id})
+func (r *Handler) GetWorkflowsWorkflowIdRunsRunId(ctx context.Context, request gen.GetWorkflowsWorkflowIdRunsRunIdRequestObject) (gen.GetWorkflowsWorkflowIdRunsRunIdResponseObject, error) {
+	run, err := r.schedulerCase.GetWorkflowRun(ctx, request.WorkflowId, request.RunId)
+	if err != nil {
+		if errors.Is(err, cases.ErrNotFound) {
+			return gen.GetWorkflowsWorkflowIdRunsRunId404Response{}, nil
+		}
+		return nil, err // 500
+	}
+	return gen.GetWorkflowsWorkflowIdRunsRunId200JSONResponse(toGenWorkflowRun(run)), nil
+}
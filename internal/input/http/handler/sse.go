@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"scheduler/internal/entity"
+	"scheduler/internal/input/http/gen"
+	"strconv"
+)
+
+var _ gen.GetExecutionsExecutionIdLogsResponseObject = (*logStream)(nil)
+
+// logStream отдает логи выполнения как Server-Sent Events, пока выполнение не завершится.
+// Сгенерированный ответ text/event-stream буферизует тело, поэтому события пишутся напрямую
+type logStream struct {
+	ctx     context.Context
+	cases   JobsCases
+	execID  string
+	after   int64
+	backlog []entity.ExecutionLog // куски, прочитанные при проверке запроса
+}
+
+func (s *logStream) VisitGetExecutionsExecutionIdLogsResponse(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Прокси вроде nginx иначе копят события до конца ответа
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	send := func(l entity.ExecutionLog) error {
+		if err := writeEvent(w, "log", strconv.FormatInt(l.Seq, 10), toGenExecutionLog(l)); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	after := s.after
+	for _, l := range s.backlog {
+		if err := send(l); err != nil {
+			return nil // клиент отключился
+		}
+		after = l.Seq
+	}
+	if err := rc.Flush(); err != nil {
+		return nil
+	}
+
+	exec, err := s.cases.FollowExecutionLogs(s.ctx, s.execID, after, send)
+	if err != nil {
+		// Статус ответа уже отправлен, о сбое остается сообщить событием
+		if s.ctx.Err() == nil {
+			_ = writeEvent(w, "error", "", map[string]string{"error": err.Error()})
+			_ = rc.Flush()
+		}
+		return nil
+	}
+	if err := writeEvent(w, "end", "", toGenExecution(exec)); err != nil {
+		return nil
+	}
+	_ = rc.Flush()
+	return nil
+}
+
+// writeEvent пишет одно событие SSE с данными в JSON
+func writeEvent(w http.ResponseWriter, event string, id string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
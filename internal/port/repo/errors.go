@@ -4,4 +4,5 @@ import "errors"
 
 var (
 	ErrNotFound = errors.New("not found")
+	ErrConflict = errors.New("conflict")
 )
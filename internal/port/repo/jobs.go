@@ -1,29 +1,229 @@
 package repo
 
+import "math"
+
 const (
+	Cancelled Status = "cancelled"
 	Completed Status = "completed"
 	Failed    Status = "failed"
+	Paused    Status = "paused"
 	Queued    Status = "queued"
 	Running   Status = "running"
+	Skipped   Status = "skipped"
+	TimedOut  Status = "timed_out"
 )
 
 type Status string
 
 type JobDTO struct {
-	ID             string
-	Once           *string
-	Interval       *string
-	Status         Status
-	CreatedAt      int64
-	LastFinishedAt int64
-	Payload        map[string]any
+	ID                string
+	Type              string
+	Once              *string
+	Interval          *string
+	Cron              *string
+	Status            Status
+	CreatedAt         int64
+	LastFinishedAt    int64
+	NextRunAt         *int64
+	Payload           map[string]any
+	Timezone          string
+	RetryPolicy       *RetryPolicyDTO
+	Timeout           *int64 // milliseconds
+	MisfirePolicy     string
+	ConcurrencyPolicy string
+	WorkflowID        *string // set for the jobs of workflow nodes
+	Notifications     []NotificationHookDTO
+	Labels            map[string]string
+}
+
+// RetryPolicyDTO is stored as JSON together with the job.
+type RetryPolicyDTO struct {
+	MaxAttempts     int      `json:"maxAttempts"`
+	InitialDelay    string   `json:"initialDelay,omitempty"`
+	Multiplier      float64  `json:"multiplier,omitempty"`
+	MaxDelay        string   `json:"maxDelay,omitempty"`
+	Jitter          float64  `json:"jitter,omitempty"`
+	RetryableErrors []string `json:"retryableErrors,omitempty"`
+}
+
+// NotificationHookDTO is stored as JSON together with the job.
+type NotificationHookDTO struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Events []string `json:"events"`
 }
 
 type ExecutionDTO struct {
+	ID             string
+	JobID          string
+	WorkerID       string
+	Status         string
+	Attempt        int
+	Trigger        string
+	Payload        map[string]any // overrides the job payload when not nil
+	WorkflowRunID  string
+	ScheduledAt    int64
+	StartedAt      int64
+	FinishedAt     int64
+	LeaseExpiresAt int64
+	Output         string
+	Error          string
+	Reason         string
+}
+
+type WorkflowDTO struct {
+	ID        string
+	Name      string
+	CreatedAt int64
+	Nodes     []WorkflowNodeDTO
+}
+
+// WorkflowNodeDTO is stored as JSON together with the workflow.
+type WorkflowNodeDTO struct {
+	Name      string   `json:"name"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+	JobID     string   `json:"jobId"`
+}
+
+type WorkflowRunDTO struct {
 	ID         string
-	JobID      string
-	WorkerID   string
-	Status     string
-	StartedAt  int64
+	WorkflowID string
+	Status     Status
+	CreatedAt  int64
 	FinishedAt int64
+	Revision   int // guards concurrent updates of the run
+}
+
+type DeadLetterDTO struct {
+	ID          string
+	JobID       string
+	ExecutionID string
+	Attempts    int
+	Reason      string
+	Output      string
+	CreatedAt   int64
+}
+
+// ExecutionLogDTO is a chunk of the output of an execution, Seq orders the chunks.
+type ExecutionLogDTO struct {
+	Seq         int64
+	ExecutionID string
+	Stream      string
+	Data        string
+	CreatedAt   int64
+}
+
+// NotificationDTO is a delivery of an event to a webhook, URL and Secret are copied from the hook.
+type NotificationDTO struct {
+	ID             string
+	JobID          string
+	ExecutionID    string
+	Event          string
+	URL            string
+	Secret         string
+	Body           []byte // JSON
+	Status         string
+	Attempts       int
+	LastStatusCode int
+	LastError      string
+	CreatedAt      int64
+	NextAttemptAt  int64
+	DeliveredAt    int64
+}
+
+type LeaseDTO struct {
+	Execution ExecutionDTO
+	Job       JobDTO
+}
+
+// ShardsDTO selects the jobs whose ID hashes into one of Owned out of Count shards.
+type ShardsDTO struct {
+	Count int
+	Owned []int
+}
+
+// Columns jobs are sorted by.
+const (
+	SortCreatedAt      = "created_at"
+	SortLastFinishedAt = "last_finished_at"
+	SortNextRunAt      = "next_run_at"
+)
+
+// SortLast is the sort key of a missing time, such as the next run of a job which will not fire anymore
+// or the start of a queued execution, so that such rows come after all others in ascending order.
+const SortLast int64 = math.MaxInt64
+
+// JobsQueryDTO selects up to Limit jobs sorted by the Sort column and then by ID.
+type JobsQueryDTO struct {
+	Status *string
+	// Schedule is once, interval or cron, the jobs of workflow nodes have none
+	Schedule      string
+	CreatedAfter  *int64 // inclusive
+	CreatedBefore *int64 // exclusive
+	// Labels must all hold
+	Labels []LabelRequirementDTO
+	Sort   string
+	Desc   bool
+	// After is the position of the last job of the previous page
+	After *CursorDTO
+	Limit uint64
+}
+
+// CursorDTO is the position of a row in a sorted list: the value of the sort column and the ID.
+type CursorDTO struct {
+	Key int64
+	ID  string
+}
+
+// SortKey returns the value of the sort column of the job.
+func (j *JobDTO) SortKey(sort string) int64 {
+	switch sort {
+	case SortLastFinishedAt:
+		return j.LastFinishedAt
+	case SortNextRunAt:
+		if j.NextRunAt == nil {
+			return SortLast
+		}
+		return *j.NextRunAt
+	default:
+		return j.CreatedAt
+	}
+}
+
+// ExecutionsQueryDTO selects up to Limit executions of a job in the order of the start time and then of the ID.
+type ExecutionsQueryDTO struct {
+	WorkerID      *string
+	Status        *string
+	StartedAfter  *int64 // inclusive
+	StartedBefore *int64 // exclusive
+	Desc          bool
+	// After is the position of the last execution of the previous page
+	After *CursorDTO
+	Limit uint64
+}
+
+// SortKey returns the start time of the execution, SortLast if it has not started.
+func (e *ExecutionDTO) SortKey() int64 {
+	if e.StartedAt == 0 {
+		return SortLast
+	}
+	return e.StartedAt
+}
+
+// Operators of label requirements.
+const (
+	LabelEquals    = "="
+	LabelNotEquals = "!="
+	LabelIn        = "in"
+	LabelNotIn     = "notin"
+	LabelExists    = "exists"
+	LabelNotExists = "!exists"
+)
+
+// LabelRequirementDTO is a condition on a label of jobs. Like in Kubernetes, != and notin
+// also match the jobs without the label.
+type LabelRequirementDTO struct {
+	Key    string
+	Op     string
+	Values []string // one for = and !=, none for exists and !exists
 }
@@ -89,6 +89,33 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetDeadLetters request
+	GetDeadLetters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDeadLettersDeadLetterId request
+	DeleteDeadLettersDeadLetterId(ctx context.Context, deadLetterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDeadLettersDeadLetterIdRequeue request
+	PostDeadLettersDeadLetterIdRequeue(ctx context.Context, deadLetterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostExecutionsExecutionIdCompleteWithBody request with any body
+	PostExecutionsExecutionIdCompleteWithBody(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostExecutionsExecutionIdComplete(ctx context.Context, executionId string, body PostExecutionsExecutionIdCompleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostExecutionsExecutionIdHeartbeatWithBody request with any body
+	PostExecutionsExecutionIdHeartbeatWithBody(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostExecutionsExecutionIdHeartbeat(ctx context.Context, executionId string, body PostExecutionsExecutionIdHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExecutionsExecutionIdLogs request
+	GetExecutionsExecutionIdLogs(ctx context.Context, executionId string, params *GetExecutionsExecutionIdLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostExecutionsExecutionIdLogsWithBody request with any body
+	PostExecutionsExecutionIdLogsWithBody(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostExecutionsExecutionIdLogs(ctx context.Context, executionId string, body PostExecutionsExecutionIdLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetJobs request
 	GetJobs(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -105,6 +132,157 @@ type ClientInterface interface {
 
 	// GetJobsJobIdExecutions request
 	GetJobsJobIdExecutions(ctx context.Context, jobId string, params *GetJobsJobIdExecutionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJobsJobIdNotifications request
+	GetJobsJobIdNotifications(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostJobsJobIdPause request
+	PostJobsJobIdPause(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostJobsJobIdResume request
+	PostJobsJobIdResume(ctx context.Context, jobId string, params *PostJobsJobIdResumeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostJobsJobIdTriggerWithBody request with any body
+	PostJobsJobIdTriggerWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostJobsJobIdTrigger(ctx context.Context, jobId string, body PostJobsJobIdTriggerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWorkersWorkerIdLease request
+	PostWorkersWorkerIdLease(ctx context.Context, workerId string, params *PostWorkersWorkerIdLeaseParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWorkflowsWithBody request with any body
+	PostWorkflowsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWorkflows(ctx context.Context, body PostWorkflowsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkflowsWorkflowId request
+	GetWorkflowsWorkflowId(ctx context.Context, workflowId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWorkflowsWorkflowIdRuns request
+	PostWorkflowsWorkflowIdRuns(ctx context.Context, workflowId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkflowsWorkflowIdRunsRunId request
+	GetWorkflowsWorkflowIdRunsRunId(ctx context.Context, workflowId string, runId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetDeadLetters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeadLettersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteDeadLettersDeadLetterId(ctx context.Context, deadLetterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDeadLettersDeadLetterIdRequest(c.Server, deadLetterId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostDeadLettersDeadLetterIdRequeue(ctx context.Context, deadLetterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeadLettersDeadLetterIdRequeueRequest(c.Server, deadLetterId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostExecutionsExecutionIdCompleteWithBody(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostExecutionsExecutionIdCompleteRequestWithBody(c.Server, executionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostExecutionsExecutionIdComplete(ctx context.Context, executionId string, body PostExecutionsExecutionIdCompleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostExecutionsExecutionIdCompleteRequest(c.Server, executionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostExecutionsExecutionIdHeartbeatWithBody(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostExecutionsExecutionIdHeartbeatRequestWithBody(c.Server, executionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostExecutionsExecutionIdHeartbeat(ctx context.Context, executionId string, body PostExecutionsExecutionIdHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostExecutionsExecutionIdHeartbeatRequest(c.Server, executionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetExecutionsExecutionIdLogs(ctx context.Context, executionId string, params *GetExecutionsExecutionIdLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExecutionsExecutionIdLogsRequest(c.Server, executionId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostExecutionsExecutionIdLogsWithBody(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostExecutionsExecutionIdLogsRequestWithBody(c.Server, executionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostExecutionsExecutionIdLogs(ctx context.Context, executionId string, body PostExecutionsExecutionIdLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostExecutionsExecutionIdLogsRequest(c.Server, executionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) GetJobs(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -179,68 +357,140 @@ func (c *Client) GetJobsJobIdExecutions(ctx context.Context, jobId string, param
 	return c.Client.Do(req)
 }
 
-// NewGetJobsRequest generates requests for GetJobs
-func NewGetJobsRequest(server string, params *GetJobsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetJobsJobIdNotifications(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobsJobIdNotificationsRequest(c.Server, jobId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/jobs")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PostJobsJobIdPause(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostJobsJobIdPauseRequest(c.Server, jobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostJobsJobIdResume(ctx context.Context, jobId string, params *PostJobsJobIdResumeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostJobsJobIdResumeRequest(c.Server, jobId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) PostJobsJobIdTriggerWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostJobsJobIdTriggerRequestWithBody(c.Server, jobId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Status != nil {
+func (c *Client) PostJobsJobIdTrigger(ctx context.Context, jobId string, body PostJobsJobIdTriggerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostJobsJobIdTriggerRequest(c.Server, jobId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) PostWorkersWorkerIdLease(ctx context.Context, workerId string, params *PostWorkersWorkerIdLeaseParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWorkersWorkerIdLeaseRequest(c.Server, workerId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) PostWorkflowsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWorkflowsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) PostWorkflows(ctx context.Context, body PostWorkflowsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWorkflowsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetWorkflowsWorkflowId(ctx context.Context, workflowId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkflowsWorkflowIdRequest(c.Server, workflowId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PostWorkflowsWorkflowIdRuns(ctx context.Context, workflowId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWorkflowsWorkflowIdRunsRequest(c.Server, workflowId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostJobsRequest calls the generic PostJobs builder with application/json body
-func NewPostJobsRequest(server string, body PostJobsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetWorkflowsWorkflowIdRunsRunId(ctx context.Context, workflowId string, runId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkflowsWorkflowIdRunsRunIdRequest(c.Server, workflowId, runId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostJobsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostJobsRequestWithBody generates requests for PostJobs with any type of body
-func NewPostJobsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetDeadLettersRequest generates requests for GetDeadLetters
+func NewGetDeadLettersRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -248,7 +498,7 @@ func NewPostJobsRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/jobs")
+	operationPath := fmt.Sprintf("/dead-letters")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -258,23 +508,21 @@ func NewPostJobsRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteJobsJobIdRequest generates requests for DeleteJobsJobId
-func NewDeleteJobsJobIdRequest(server string, jobId string) (*http.Request, error) {
+// NewDeleteDeadLettersDeadLetterIdRequest generates requests for DeleteDeadLettersDeadLetterId
+func NewDeleteDeadLettersDeadLetterIdRequest(server string, deadLetterId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dead_letter_id", runtime.ParamLocationPath, deadLetterId)
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +532,7 @@ func NewDeleteJobsJobIdRequest(server string, jobId string) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dead-letters/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -302,13 +550,13 @@ func NewDeleteJobsJobIdRequest(server string, jobId string) (*http.Request, erro
 	return req, nil
 }
 
-// NewGetJobsJobIdRequest generates requests for GetJobsJobId
-func NewGetJobsJobIdRequest(server string, jobId string) (*http.Request, error) {
+// NewPostDeadLettersDeadLetterIdRequeueRequest generates requests for PostDeadLettersDeadLetterIdRequeue
+func NewPostDeadLettersDeadLetterIdRequeueRequest(server string, deadLetterId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dead_letter_id", runtime.ParamLocationPath, deadLetterId)
 	if err != nil {
 		return nil, err
 	}
@@ -318,7 +566,7 @@ func NewGetJobsJobIdRequest(server string, jobId string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dead-letters/%s/requeue", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -328,7 +576,7 @@ func NewGetJobsJobIdRequest(server string, jobId string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -336,13 +584,24 @@ func NewGetJobsJobIdRequest(server string, jobId string) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetJobsJobIdExecutionsRequest generates requests for GetJobsJobIdExecutions
-func NewGetJobsJobIdExecutionsRequest(server string, jobId string, params *GetJobsJobIdExecutionsParams) (*http.Request, error) {
-	var err error
+// NewPostExecutionsExecutionIdCompleteRequest calls the generic PostExecutionsExecutionIdComplete builder with application/json body
+func NewPostExecutionsExecutionIdCompleteRequest(server string, executionId string, body PostExecutionsExecutionIdCompleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostExecutionsExecutionIdCompleteRequestWithBody(server, executionId, "application/json", bodyReader)
+}
+
+// NewPostExecutionsExecutionIdCompleteRequestWithBody generates requests for PostExecutionsExecutionIdComplete with any type of body
+func NewPostExecutionsExecutionIdCompleteRequestWithBody(server string, executionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "execution_id", runtime.ParamLocationPath, executionId)
 	if err != nil {
 		return nil, err
 	}
@@ -352,7 +611,90 @@ func NewGetJobsJobIdExecutionsRequest(server string, jobId string, params *GetJo
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/jobs/%s/executions", pathParam0)
+	operationPath := fmt.Sprintf("/executions/%s/complete", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostExecutionsExecutionIdHeartbeatRequest calls the generic PostExecutionsExecutionIdHeartbeat builder with application/json body
+func NewPostExecutionsExecutionIdHeartbeatRequest(server string, executionId string, body PostExecutionsExecutionIdHeartbeatJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostExecutionsExecutionIdHeartbeatRequestWithBody(server, executionId, "application/json", bodyReader)
+}
+
+// NewPostExecutionsExecutionIdHeartbeatRequestWithBody generates requests for PostExecutionsExecutionIdHeartbeat with any type of body
+func NewPostExecutionsExecutionIdHeartbeatRequestWithBody(server string, executionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "execution_id", runtime.ParamLocationPath, executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/executions/%s/heartbeat", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetExecutionsExecutionIdLogsRequest generates requests for GetExecutionsExecutionIdLogs
+func NewGetExecutionsExecutionIdLogsRequest(server string, executionId string, params *GetExecutionsExecutionIdLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "execution_id", runtime.ParamLocationPath, executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/executions/%s/logs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -365,9 +707,25 @@ func NewGetJobsJobIdExecutionsRequest(server string, jobId string, params *GetJo
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.WorkerId != nil {
+		if params.Follow != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "worker_id", runtime.ParamLocationQuery, *params.WorkerId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -389,248 +747,2104 @@ func NewGetJobsJobIdExecutionsRequest(server string, jobId string, params *GetJo
 		return nil, err
 	}
 
-	return req, nil
-}
+	if params != nil {
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
 		}
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return req, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewPostExecutionsExecutionIdLogsRequest calls the generic PostExecutionsExecutionIdLogs builder with application/json body
+func NewPostExecutionsExecutionIdLogsRequest(server string, executionId string, body PostExecutionsExecutionIdLogsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPostExecutionsExecutionIdLogsRequestWithBody(server, executionId, "application/json", bodyReader)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewPostExecutionsExecutionIdLogsRequestWithBody generates requests for PostExecutionsExecutionIdLogs with any type of body
+func NewPostExecutionsExecutionIdLogsRequestWithBody(server string, executionId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "execution_id", runtime.ParamLocationPath, executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/executions/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetJobsWithResponse request
-	GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error)
+// NewGetJobsRequest generates requests for GetJobs
+func NewGetJobsRequest(server string, params *GetJobsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Schedule != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "schedule", runtime.ParamLocationQuery, *params.Schedule); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Selector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "selector", runtime.ParamLocationQuery, *params.Selector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostJobsRequest calls the generic PostJobs builder with application/json body
+func NewPostJobsRequest(server string, body PostJobsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostJobsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostJobsRequestWithBody generates requests for PostJobs with any type of body
+func NewPostJobsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteJobsJobIdRequest generates requests for DeleteJobsJobId
+func NewDeleteJobsJobIdRequest(server string, jobId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetJobsJobIdRequest generates requests for GetJobsJobId
+func NewGetJobsJobIdRequest(server string, jobId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetJobsJobIdExecutionsRequest generates requests for GetJobsJobIdExecutions
+func NewGetJobsJobIdExecutionsRequest(server string, jobId string, params *GetJobsJobIdExecutionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s/executions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.WorkerId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "worker_id", runtime.ParamLocationQuery, *params.WorkerId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startedAfter", runtime.ParamLocationQuery, *params.StartedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startedBefore", runtime.ParamLocationQuery, *params.StartedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetJobsJobIdNotificationsRequest generates requests for GetJobsJobIdNotifications
+func NewGetJobsJobIdNotificationsRequest(server string, jobId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s/notifications", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostJobsJobIdPauseRequest generates requests for PostJobsJobIdPause
+func NewPostJobsJobIdPauseRequest(server string, jobId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostJobsJobIdResumeRequest generates requests for PostJobsJobIdResume
+func NewPostJobsJobIdResumeRequest(server string, jobId string, params *PostJobsJobIdResumeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.MisfirePolicy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "misfirePolicy", runtime.ParamLocationQuery, *params.MisfirePolicy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostJobsJobIdTriggerRequest calls the generic PostJobsJobIdTrigger builder with application/json body
+func NewPostJobsJobIdTriggerRequest(server string, jobId string, body PostJobsJobIdTriggerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostJobsJobIdTriggerRequestWithBody(server, jobId, "application/json", bodyReader)
+}
+
+// NewPostJobsJobIdTriggerRequestWithBody generates requests for PostJobsJobIdTrigger with any type of body
+func NewPostJobsJobIdTriggerRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s/trigger", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostWorkersWorkerIdLeaseRequest generates requests for PostWorkersWorkerIdLease
+func NewPostWorkersWorkerIdLeaseRequest(server string, workerId string, params *PostWorkersWorkerIdLeaseParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workers/%s/lease", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWorkflowsRequest calls the generic PostWorkflows builder with application/json body
+func NewPostWorkflowsRequest(server string, body PostWorkflowsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWorkflowsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWorkflowsRequestWithBody generates requests for PostWorkflows with any type of body
+func NewPostWorkflowsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workflows")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWorkflowsWorkflowIdRequest generates requests for GetWorkflowsWorkflowId
+func NewGetWorkflowsWorkflowIdRequest(server string, workflowId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow_id", runtime.ParamLocationPath, workflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWorkflowsWorkflowIdRunsRequest generates requests for PostWorkflowsWorkflowIdRuns
+func NewPostWorkflowsWorkflowIdRunsRequest(server string, workflowId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow_id", runtime.ParamLocationPath, workflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workflows/%s/runs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkflowsWorkflowIdRunsRunIdRequest generates requests for GetWorkflowsWorkflowIdRunsRunId
+func NewGetWorkflowsWorkflowIdRunsRunIdRequest(server string, workflowId string, runId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow_id", runtime.ParamLocationPath, workflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workflows/%s/runs/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetDeadLettersWithResponse request
+	GetDeadLettersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDeadLettersResponse, error)
+
+	// DeleteDeadLettersDeadLetterIdWithResponse request
+	DeleteDeadLettersDeadLetterIdWithResponse(ctx context.Context, deadLetterId string, reqEditors ...RequestEditorFn) (*DeleteDeadLettersDeadLetterIdResponse, error)
+
+	// PostDeadLettersDeadLetterIdRequeueWithResponse request
+	PostDeadLettersDeadLetterIdRequeueWithResponse(ctx context.Context, deadLetterId string, reqEditors ...RequestEditorFn) (*PostDeadLettersDeadLetterIdRequeueResponse, error)
+
+	// PostExecutionsExecutionIdCompleteWithBodyWithResponse request with any body
+	PostExecutionsExecutionIdCompleteWithBodyWithResponse(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdCompleteResponse, error)
+
+	PostExecutionsExecutionIdCompleteWithResponse(ctx context.Context, executionId string, body PostExecutionsExecutionIdCompleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdCompleteResponse, error)
+
+	// PostExecutionsExecutionIdHeartbeatWithBodyWithResponse request with any body
+	PostExecutionsExecutionIdHeartbeatWithBodyWithResponse(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdHeartbeatResponse, error)
+
+	PostExecutionsExecutionIdHeartbeatWithResponse(ctx context.Context, executionId string, body PostExecutionsExecutionIdHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdHeartbeatResponse, error)
+
+	// GetExecutionsExecutionIdLogsWithResponse request
+	GetExecutionsExecutionIdLogsWithResponse(ctx context.Context, executionId string, params *GetExecutionsExecutionIdLogsParams, reqEditors ...RequestEditorFn) (*GetExecutionsExecutionIdLogsResponse, error)
+
+	// PostExecutionsExecutionIdLogsWithBodyWithResponse request with any body
+	PostExecutionsExecutionIdLogsWithBodyWithResponse(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdLogsResponse, error)
+
+	PostExecutionsExecutionIdLogsWithResponse(ctx context.Context, executionId string, body PostExecutionsExecutionIdLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdLogsResponse, error)
+
+	// GetJobsWithResponse request
+	GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error)
+
+	// PostJobsWithBodyWithResponse request with any body
+	PostJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJobsResponse, error)
+
+	PostJobsWithResponse(ctx context.Context, body PostJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJobsResponse, error)
+
+	// DeleteJobsJobIdWithResponse request
+	DeleteJobsJobIdWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobsJobIdResponse, error)
+
+	// GetJobsJobIdWithResponse request
+	GetJobsJobIdWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*GetJobsJobIdResponse, error)
+
+	// GetJobsJobIdExecutionsWithResponse request
+	GetJobsJobIdExecutionsWithResponse(ctx context.Context, jobId string, params *GetJobsJobIdExecutionsParams, reqEditors ...RequestEditorFn) (*GetJobsJobIdExecutionsResponse, error)
+
+	// GetJobsJobIdNotificationsWithResponse request
+	GetJobsJobIdNotificationsWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*GetJobsJobIdNotificationsResponse, error)
+
+	// PostJobsJobIdPauseWithResponse request
+	PostJobsJobIdPauseWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*PostJobsJobIdPauseResponse, error)
+
+	// PostJobsJobIdResumeWithResponse request
+	PostJobsJobIdResumeWithResponse(ctx context.Context, jobId string, params *PostJobsJobIdResumeParams, reqEditors ...RequestEditorFn) (*PostJobsJobIdResumeResponse, error)
+
+	// PostJobsJobIdTriggerWithBodyWithResponse request with any body
+	PostJobsJobIdTriggerWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJobsJobIdTriggerResponse, error)
+
+	PostJobsJobIdTriggerWithResponse(ctx context.Context, jobId string, body PostJobsJobIdTriggerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJobsJobIdTriggerResponse, error)
+
+	// PostWorkersWorkerIdLeaseWithResponse request
+	PostWorkersWorkerIdLeaseWithResponse(ctx context.Context, workerId string, params *PostWorkersWorkerIdLeaseParams, reqEditors ...RequestEditorFn) (*PostWorkersWorkerIdLeaseResponse, error)
+
+	// PostWorkflowsWithBodyWithResponse request with any body
+	PostWorkflowsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWorkflowsResponse, error)
+
+	PostWorkflowsWithResponse(ctx context.Context, body PostWorkflowsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWorkflowsResponse, error)
+
+	// GetWorkflowsWorkflowIdWithResponse request
+	GetWorkflowsWorkflowIdWithResponse(ctx context.Context, workflowId string, reqEditors ...RequestEditorFn) (*GetWorkflowsWorkflowIdResponse, error)
+
+	// PostWorkflowsWorkflowIdRunsWithResponse request
+	PostWorkflowsWorkflowIdRunsWithResponse(ctx context.Context, workflowId string, reqEditors ...RequestEditorFn) (*PostWorkflowsWorkflowIdRunsResponse, error)
+
+	// GetWorkflowsWorkflowIdRunsRunIdWithResponse request
+	GetWorkflowsWorkflowIdRunsRunIdWithResponse(ctx context.Context, workflowId string, runId string, reqEditors ...RequestEditorFn) (*GetWorkflowsWorkflowIdRunsRunIdResponse, error)
+}
+
+type GetDeadLettersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DeadLetter
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeadLettersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeadLettersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDeadLettersDeadLetterIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDeadLettersDeadLetterIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDeadLettersDeadLetterIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDeadLettersDeadLetterIdRequeueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDeadLettersDeadLetterIdRequeueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDeadLettersDeadLetterIdRequeueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostExecutionsExecutionIdCompleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostExecutionsExecutionIdCompleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostExecutionsExecutionIdCompleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostExecutionsExecutionIdHeartbeatResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HeartbeatResult
+}
+
+// Status returns HTTPResponse.Status
+func (r PostExecutionsExecutionIdHeartbeatResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostExecutionsExecutionIdHeartbeatResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExecutionsExecutionIdLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExecutionLog
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExecutionsExecutionIdLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExecutionsExecutionIdLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostExecutionsExecutionIdLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostExecutionsExecutionIdLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostExecutionsExecutionIdLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *JobPage
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostJobsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *string
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteJobsJobIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteJobsJobIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteJobsJobIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobsJobIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsJobIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsJobIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobsJobIdExecutionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ExecutionPage
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsJobIdExecutionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsJobIdExecutionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobsJobIdNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]NotificationDelivery
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobsJobIdNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobsJobIdNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostJobsJobIdPauseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostJobsJobIdPauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostJobsJobIdPauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostJobsJobIdResumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostJobsJobIdResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostJobsJobIdResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostJobsJobIdTriggerResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *string
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostJobsJobIdTriggerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostJobsJobIdTriggerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWorkersWorkerIdLeaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Lease
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWorkersWorkerIdLeaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWorkersWorkerIdLeaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWorkflowsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *string
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWorkflowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWorkflowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkflowsWorkflowIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Workflow
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkflowsWorkflowIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkflowsWorkflowIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWorkflowsWorkflowIdRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWorkflowsWorkflowIdRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWorkflowsWorkflowIdRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkflowsWorkflowIdRunsRunIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkflowsWorkflowIdRunsRunIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkflowsWorkflowIdRunsRunIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetDeadLettersWithResponse request returning *GetDeadLettersResponse
+func (c *ClientWithResponses) GetDeadLettersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDeadLettersResponse, error) {
+	rsp, err := c.GetDeadLetters(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeadLettersResponse(rsp)
+}
+
+// DeleteDeadLettersDeadLetterIdWithResponse request returning *DeleteDeadLettersDeadLetterIdResponse
+func (c *ClientWithResponses) DeleteDeadLettersDeadLetterIdWithResponse(ctx context.Context, deadLetterId string, reqEditors ...RequestEditorFn) (*DeleteDeadLettersDeadLetterIdResponse, error) {
+	rsp, err := c.DeleteDeadLettersDeadLetterId(ctx, deadLetterId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDeadLettersDeadLetterIdResponse(rsp)
+}
+
+// PostDeadLettersDeadLetterIdRequeueWithResponse request returning *PostDeadLettersDeadLetterIdRequeueResponse
+func (c *ClientWithResponses) PostDeadLettersDeadLetterIdRequeueWithResponse(ctx context.Context, deadLetterId string, reqEditors ...RequestEditorFn) (*PostDeadLettersDeadLetterIdRequeueResponse, error) {
+	rsp, err := c.PostDeadLettersDeadLetterIdRequeue(ctx, deadLetterId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeadLettersDeadLetterIdRequeueResponse(rsp)
+}
+
+// PostExecutionsExecutionIdCompleteWithBodyWithResponse request with arbitrary body returning *PostExecutionsExecutionIdCompleteResponse
+func (c *ClientWithResponses) PostExecutionsExecutionIdCompleteWithBodyWithResponse(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdCompleteResponse, error) {
+	rsp, err := c.PostExecutionsExecutionIdCompleteWithBody(ctx, executionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostExecutionsExecutionIdCompleteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostExecutionsExecutionIdCompleteWithResponse(ctx context.Context, executionId string, body PostExecutionsExecutionIdCompleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdCompleteResponse, error) {
+	rsp, err := c.PostExecutionsExecutionIdComplete(ctx, executionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostExecutionsExecutionIdCompleteResponse(rsp)
+}
+
+// PostExecutionsExecutionIdHeartbeatWithBodyWithResponse request with arbitrary body returning *PostExecutionsExecutionIdHeartbeatResponse
+func (c *ClientWithResponses) PostExecutionsExecutionIdHeartbeatWithBodyWithResponse(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdHeartbeatResponse, error) {
+	rsp, err := c.PostExecutionsExecutionIdHeartbeatWithBody(ctx, executionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostExecutionsExecutionIdHeartbeatResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostExecutionsExecutionIdHeartbeatWithResponse(ctx context.Context, executionId string, body PostExecutionsExecutionIdHeartbeatJSONRequestBody, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdHeartbeatResponse, error) {
+	rsp, err := c.PostExecutionsExecutionIdHeartbeat(ctx, executionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostExecutionsExecutionIdHeartbeatResponse(rsp)
+}
+
+// GetExecutionsExecutionIdLogsWithResponse request returning *GetExecutionsExecutionIdLogsResponse
+func (c *ClientWithResponses) GetExecutionsExecutionIdLogsWithResponse(ctx context.Context, executionId string, params *GetExecutionsExecutionIdLogsParams, reqEditors ...RequestEditorFn) (*GetExecutionsExecutionIdLogsResponse, error) {
+	rsp, err := c.GetExecutionsExecutionIdLogs(ctx, executionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExecutionsExecutionIdLogsResponse(rsp)
+}
+
+// PostExecutionsExecutionIdLogsWithBodyWithResponse request with arbitrary body returning *PostExecutionsExecutionIdLogsResponse
+func (c *ClientWithResponses) PostExecutionsExecutionIdLogsWithBodyWithResponse(ctx context.Context, executionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdLogsResponse, error) {
+	rsp, err := c.PostExecutionsExecutionIdLogsWithBody(ctx, executionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostExecutionsExecutionIdLogsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostExecutionsExecutionIdLogsWithResponse(ctx context.Context, executionId string, body PostExecutionsExecutionIdLogsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostExecutionsExecutionIdLogsResponse, error) {
+	rsp, err := c.PostExecutionsExecutionIdLogs(ctx, executionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostExecutionsExecutionIdLogsResponse(rsp)
+}
+
+// GetJobsWithResponse request returning *GetJobsResponse
+func (c *ClientWithResponses) GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error) {
+	rsp, err := c.GetJobs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsResponse(rsp)
+}
+
+// PostJobsWithBodyWithResponse request with arbitrary body returning *PostJobsResponse
+func (c *ClientWithResponses) PostJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJobsResponse, error) {
+	rsp, err := c.PostJobsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJobsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostJobsWithResponse(ctx context.Context, body PostJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJobsResponse, error) {
+	rsp, err := c.PostJobs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJobsResponse(rsp)
+}
+
+// DeleteJobsJobIdWithResponse request returning *DeleteJobsJobIdResponse
+func (c *ClientWithResponses) DeleteJobsJobIdWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobsJobIdResponse, error) {
+	rsp, err := c.DeleteJobsJobId(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteJobsJobIdResponse(rsp)
+}
+
+// GetJobsJobIdWithResponse request returning *GetJobsJobIdResponse
+func (c *ClientWithResponses) GetJobsJobIdWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*GetJobsJobIdResponse, error) {
+	rsp, err := c.GetJobsJobId(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsJobIdResponse(rsp)
+}
+
+// GetJobsJobIdExecutionsWithResponse request returning *GetJobsJobIdExecutionsResponse
+func (c *ClientWithResponses) GetJobsJobIdExecutionsWithResponse(ctx context.Context, jobId string, params *GetJobsJobIdExecutionsParams, reqEditors ...RequestEditorFn) (*GetJobsJobIdExecutionsResponse, error) {
+	rsp, err := c.GetJobsJobIdExecutions(ctx, jobId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsJobIdExecutionsResponse(rsp)
+}
+
+// GetJobsJobIdNotificationsWithResponse request returning *GetJobsJobIdNotificationsResponse
+func (c *ClientWithResponses) GetJobsJobIdNotificationsWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*GetJobsJobIdNotificationsResponse, error) {
+	rsp, err := c.GetJobsJobIdNotifications(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobsJobIdNotificationsResponse(rsp)
+}
+
+// PostJobsJobIdPauseWithResponse request returning *PostJobsJobIdPauseResponse
+func (c *ClientWithResponses) PostJobsJobIdPauseWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*PostJobsJobIdPauseResponse, error) {
+	rsp, err := c.PostJobsJobIdPause(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJobsJobIdPauseResponse(rsp)
+}
+
+// PostJobsJobIdResumeWithResponse request returning *PostJobsJobIdResumeResponse
+func (c *ClientWithResponses) PostJobsJobIdResumeWithResponse(ctx context.Context, jobId string, params *PostJobsJobIdResumeParams, reqEditors ...RequestEditorFn) (*PostJobsJobIdResumeResponse, error) {
+	rsp, err := c.PostJobsJobIdResume(ctx, jobId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJobsJobIdResumeResponse(rsp)
+}
+
+// PostJobsJobIdTriggerWithBodyWithResponse request with arbitrary body returning *PostJobsJobIdTriggerResponse
+func (c *ClientWithResponses) PostJobsJobIdTriggerWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJobsJobIdTriggerResponse, error) {
+	rsp, err := c.PostJobsJobIdTriggerWithBody(ctx, jobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJobsJobIdTriggerResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostJobsJobIdTriggerWithResponse(ctx context.Context, jobId string, body PostJobsJobIdTriggerJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJobsJobIdTriggerResponse, error) {
+	rsp, err := c.PostJobsJobIdTrigger(ctx, jobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJobsJobIdTriggerResponse(rsp)
+}
+
+// PostWorkersWorkerIdLeaseWithResponse request returning *PostWorkersWorkerIdLeaseResponse
+func (c *ClientWithResponses) PostWorkersWorkerIdLeaseWithResponse(ctx context.Context, workerId string, params *PostWorkersWorkerIdLeaseParams, reqEditors ...RequestEditorFn) (*PostWorkersWorkerIdLeaseResponse, error) {
+	rsp, err := c.PostWorkersWorkerIdLease(ctx, workerId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWorkersWorkerIdLeaseResponse(rsp)
+}
+
+// PostWorkflowsWithBodyWithResponse request with arbitrary body returning *PostWorkflowsResponse
+func (c *ClientWithResponses) PostWorkflowsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWorkflowsResponse, error) {
+	rsp, err := c.PostWorkflowsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWorkflowsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWorkflowsWithResponse(ctx context.Context, body PostWorkflowsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWorkflowsResponse, error) {
+	rsp, err := c.PostWorkflows(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWorkflowsResponse(rsp)
+}
+
+// GetWorkflowsWorkflowIdWithResponse request returning *GetWorkflowsWorkflowIdResponse
+func (c *ClientWithResponses) GetWorkflowsWorkflowIdWithResponse(ctx context.Context, workflowId string, reqEditors ...RequestEditorFn) (*GetWorkflowsWorkflowIdResponse, error) {
+	rsp, err := c.GetWorkflowsWorkflowId(ctx, workflowId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkflowsWorkflowIdResponse(rsp)
+}
+
+// PostWorkflowsWorkflowIdRunsWithResponse request returning *PostWorkflowsWorkflowIdRunsResponse
+func (c *ClientWithResponses) PostWorkflowsWorkflowIdRunsWithResponse(ctx context.Context, workflowId string, reqEditors ...RequestEditorFn) (*PostWorkflowsWorkflowIdRunsResponse, error) {
+	rsp, err := c.PostWorkflowsWorkflowIdRuns(ctx, workflowId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWorkflowsWorkflowIdRunsResponse(rsp)
+}
+
+// GetWorkflowsWorkflowIdRunsRunIdWithResponse request returning *GetWorkflowsWorkflowIdRunsRunIdResponse
+func (c *ClientWithResponses) GetWorkflowsWorkflowIdRunsRunIdWithResponse(ctx context.Context, workflowId string, runId string, reqEditors ...RequestEditorFn) (*GetWorkflowsWorkflowIdRunsRunIdResponse, error) {
+	rsp, err := c.GetWorkflowsWorkflowIdRunsRunId(ctx, workflowId, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkflowsWorkflowIdRunsRunIdResponse(rsp)
+}
+
+// ParseGetDeadLettersResponse parses an HTTP response from a GetDeadLettersWithResponse call
+func ParseGetDeadLettersResponse(rsp *http.Response) (*GetDeadLettersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeadLettersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DeadLetter
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDeadLettersDeadLetterIdResponse parses an HTTP response from a DeleteDeadLettersDeadLetterIdWithResponse call
+func ParseDeleteDeadLettersDeadLetterIdResponse(rsp *http.Response) (*DeleteDeadLettersDeadLetterIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDeadLettersDeadLetterIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostDeadLettersDeadLetterIdRequeueResponse parses an HTTP response from a PostDeadLettersDeadLetterIdRequeueWithResponse call
+func ParsePostDeadLettersDeadLetterIdRequeueResponse(rsp *http.Response) (*PostDeadLettersDeadLetterIdRequeueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDeadLettersDeadLetterIdRequeueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostExecutionsExecutionIdCompleteResponse parses an HTTP response from a PostExecutionsExecutionIdCompleteWithResponse call
+func ParsePostExecutionsExecutionIdCompleteResponse(rsp *http.Response) (*PostExecutionsExecutionIdCompleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostExecutionsExecutionIdCompleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostExecutionsExecutionIdHeartbeatResponse parses an HTTP response from a PostExecutionsExecutionIdHeartbeatWithResponse call
+func ParsePostExecutionsExecutionIdHeartbeatResponse(rsp *http.Response) (*PostExecutionsExecutionIdHeartbeatResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostExecutionsExecutionIdHeartbeatResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HeartbeatResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetExecutionsExecutionIdLogsResponse parses an HTTP response from a GetExecutionsExecutionIdLogsWithResponse call
+func ParseGetExecutionsExecutionIdLogsResponse(rsp *http.Response) (*GetExecutionsExecutionIdLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExecutionsExecutionIdLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ExecutionLog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/event-stream) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostExecutionsExecutionIdLogsResponse parses an HTTP response from a PostExecutionsExecutionIdLogsWithResponse call
+func ParsePostExecutionsExecutionIdLogsResponse(rsp *http.Response) (*PostExecutionsExecutionIdLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostExecutionsExecutionIdLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetJobsResponse parses an HTTP response from a GetJobsWithResponse call
+func ParseGetJobsResponse(rsp *http.Response) (*GetJobsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostJobsResponse parses an HTTP response from a PostJobsWithResponse call
+func ParsePostJobsResponse(rsp *http.Response) (*PostJobsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-	// PostJobsWithBodyWithResponse request with any body
-	PostJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJobsResponse, error)
+	}
 
-	PostJobsWithResponse(ctx context.Context, body PostJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJobsResponse, error)
+	return response, nil
+}
 
-	// DeleteJobsJobIdWithResponse request
-	DeleteJobsJobIdWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobsJobIdResponse, error)
+// ParseDeleteJobsJobIdResponse parses an HTTP response from a DeleteJobsJobIdWithResponse call
+func ParseDeleteJobsJobIdResponse(rsp *http.Response) (*DeleteJobsJobIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// GetJobsJobIdWithResponse request
-	GetJobsJobIdWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*GetJobsJobIdResponse, error)
+	response := &DeleteJobsJobIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// GetJobsJobIdExecutionsWithResponse request
-	GetJobsJobIdExecutionsWithResponse(ctx context.Context, jobId string, params *GetJobsJobIdExecutionsParams, reqEditors ...RequestEditorFn) (*GetJobsJobIdExecutionsResponse, error)
+	return response, nil
 }
 
-type GetJobsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Job
-}
+// ParseGetJobsJobIdResponse parses an HTTP response from a GetJobsJobIdWithResponse call
+func ParseGetJobsJobIdResponse(rsp *http.Response) (*GetJobsJobIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetJobsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetJobsJobIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetJobsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type PostJobsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *string
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostJobsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetJobsJobIdExecutionsResponse parses an HTTP response from a GetJobsJobIdExecutionsWithResponse call
+func ParseGetJobsJobIdExecutionsResponse(rsp *http.Response) (*GetJobsJobIdExecutionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostJobsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetJobsJobIdExecutionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteJobsJobIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExecutionPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteJobsJobIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteJobsJobIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetJobsJobIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Job
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetJobsJobIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetJobsJobIdNotificationsResponse parses an HTTP response from a GetJobsJobIdNotificationsWithResponse call
+func ParseGetJobsJobIdNotificationsResponse(rsp *http.Response) (*GetJobsJobIdNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetJobsJobIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetJobsJobIdNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetJobsJobIdExecutionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Execution
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []NotificationDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetJobsJobIdExecutionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetJobsJobIdExecutionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// GetJobsWithResponse request returning *GetJobsResponse
-func (c *ClientWithResponses) GetJobsWithResponse(ctx context.Context, params *GetJobsParams, reqEditors ...RequestEditorFn) (*GetJobsResponse, error) {
-	rsp, err := c.GetJobs(ctx, params, reqEditors...)
+// ParsePostJobsJobIdPauseResponse parses an HTTP response from a PostJobsJobIdPauseWithResponse call
+func ParsePostJobsJobIdPauseResponse(rsp *http.Response) (*PostJobsJobIdPauseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJobsResponse(rsp)
-}
 
-// PostJobsWithBodyWithResponse request with arbitrary body returning *PostJobsResponse
-func (c *ClientWithResponses) PostJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJobsResponse, error) {
-	rsp, err := c.PostJobsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostJobsJobIdPauseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostJobsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostJobsWithResponse(ctx context.Context, body PostJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJobsResponse, error) {
-	rsp, err := c.PostJobs(ctx, body, reqEditors...)
+// ParsePostJobsJobIdResumeResponse parses an HTTP response from a PostJobsJobIdResumeWithResponse call
+func ParsePostJobsJobIdResumeResponse(rsp *http.Response) (*PostJobsJobIdResumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostJobsResponse(rsp)
-}
 
-// DeleteJobsJobIdWithResponse request returning *DeleteJobsJobIdResponse
-func (c *ClientWithResponses) DeleteJobsJobIdWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobsJobIdResponse, error) {
-	rsp, err := c.DeleteJobsJobId(ctx, jobId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostJobsJobIdResumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteJobsJobIdResponse(rsp)
+
+	return response, nil
 }
 
-// GetJobsJobIdWithResponse request returning *GetJobsJobIdResponse
-func (c *ClientWithResponses) GetJobsJobIdWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*GetJobsJobIdResponse, error) {
-	rsp, err := c.GetJobsJobId(ctx, jobId, reqEditors...)
+// ParsePostJobsJobIdTriggerResponse parses an HTTP response from a PostJobsJobIdTriggerWithResponse call
+func ParsePostJobsJobIdTriggerResponse(rsp *http.Response) (*PostJobsJobIdTriggerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJobsJobIdResponse(rsp)
-}
 
-// GetJobsJobIdExecutionsWithResponse request returning *GetJobsJobIdExecutionsResponse
-func (c *ClientWithResponses) GetJobsJobIdExecutionsWithResponse(ctx context.Context, jobId string, params *GetJobsJobIdExecutionsParams, reqEditors ...RequestEditorFn) (*GetJobsJobIdExecutionsResponse, error) {
-	rsp, err := c.GetJobsJobIdExecutions(ctx, jobId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostJobsJobIdTriggerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetJobsJobIdExecutionsResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetJobsResponse parses an HTTP response from a GetJobsWithResponse call
-func ParseGetJobsResponse(rsp *http.Response) (*GetJobsResponse, error) {
+// ParsePostWorkersWorkerIdLeaseResponse parses an HTTP response from a PostWorkersWorkerIdLeaseWithResponse call
+func ParsePostWorkersWorkerIdLeaseResponse(rsp *http.Response) (*PostWorkersWorkerIdLeaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetJobsResponse{
+	response := &PostWorkersWorkerIdLeaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Job
+		var dest []Lease
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -641,15 +2855,15 @@ func ParseGetJobsResponse(rsp *http.Response) (*GetJobsResponse, error) {
 	return response, nil
 }
 
-// ParsePostJobsResponse parses an HTTP response from a PostJobsWithResponse call
-func ParsePostJobsResponse(rsp *http.Response) (*PostJobsResponse, error) {
+// ParsePostWorkflowsResponse parses an HTTP response from a PostWorkflowsWithResponse call
+func ParsePostWorkflowsResponse(rsp *http.Response) (*PostWorkflowsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostJobsResponse{
+	response := &PostWorkflowsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -662,69 +2876,86 @@ func ParsePostJobsResponse(rsp *http.Response) (*PostJobsResponse, error) {
 		}
 		response.JSON201 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteJobsJobIdResponse parses an HTTP response from a DeleteJobsJobIdWithResponse call
-func ParseDeleteJobsJobIdResponse(rsp *http.Response) (*DeleteJobsJobIdResponse, error) {
+// ParseGetWorkflowsWorkflowIdResponse parses an HTTP response from a GetWorkflowsWorkflowIdWithResponse call
+func ParseGetWorkflowsWorkflowIdResponse(rsp *http.Response) (*GetWorkflowsWorkflowIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteJobsJobIdResponse{
+	response := &GetWorkflowsWorkflowIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Workflow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetJobsJobIdResponse parses an HTTP response from a GetJobsJobIdWithResponse call
-func ParseGetJobsJobIdResponse(rsp *http.Response) (*GetJobsJobIdResponse, error) {
+// ParsePostWorkflowsWorkflowIdRunsResponse parses an HTTP response from a PostWorkflowsWorkflowIdRunsWithResponse call
+func ParsePostWorkflowsWorkflowIdRunsResponse(rsp *http.Response) (*PostWorkflowsWorkflowIdRunsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetJobsJobIdResponse{
+	response := &PostWorkflowsWorkflowIdRunsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Job
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest string
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetJobsJobIdExecutionsResponse parses an HTTP response from a GetJobsJobIdExecutionsWithResponse call
-func ParseGetJobsJobIdExecutionsResponse(rsp *http.Response) (*GetJobsJobIdExecutionsResponse, error) {
+// ParseGetWorkflowsWorkflowIdRunsRunIdResponse parses an HTTP response from a GetWorkflowsWorkflowIdRunsRunIdWithResponse call
+func ParseGetWorkflowsWorkflowIdRunsRunIdResponse(rsp *http.Response) (*GetWorkflowsWorkflowIdRunsRunIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetJobsJobIdExecutionsResponse{
+	response := &GetWorkflowsWorkflowIdRunsRunIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Execution
+		var dest WorkflowRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
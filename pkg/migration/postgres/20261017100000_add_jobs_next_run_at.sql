@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN next_run_at BIGINT NULL;
+
+-- Без next_run_at созданные ранее задания никогда не сработают: однократные ждут своего времени,
+-- интервальные срабатывают на первом тике, а пропущенное разбирает политика misfire
+UPDATE jobs
+SET next_run_at = COALESCE((EXTRACT(EPOCH FROM once AT TIME ZONE 'UTC') * 1000)::BIGINT, created_at)
+WHERE status = 'queued';
+
+CREATE INDEX jobs_next_run_at_idx ON jobs (next_run_at) WHERE next_run_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX jobs_next_run_at_idx;
+ALTER TABLE jobs DROP COLUMN next_run_at;
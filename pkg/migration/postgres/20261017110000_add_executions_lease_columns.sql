@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE executions
+    ADD COLUMN scheduled_at BIGINT NULL,
+    ADD COLUMN heartbeat_at BIGINT NULL,
+    ADD COLUMN output TEXT NULL;
+
+CREATE INDEX executions_queued_idx ON executions (scheduled_at) WHERE status = 'queued';
+
+-- +goose Down
+DROP INDEX executions_queued_idx;
+ALTER TABLE executions
+    DROP COLUMN output,
+    DROP COLUMN heartbeat_at,
+    DROP COLUMN scheduled_at;
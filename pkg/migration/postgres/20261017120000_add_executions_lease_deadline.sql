@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE executions
+    ADD COLUMN lease_expires_at BIGINT NULL,
+    ADD COLUMN reason TEXT NULL;
+
+CREATE INDEX executions_lease_expires_at_idx ON executions (lease_expires_at) WHERE status = 'running';
+
+-- +goose Down
+DROP INDEX executions_lease_expires_at_idx;
+ALTER TABLE executions
+    DROP COLUMN reason,
+    DROP COLUMN lease_expires_at;
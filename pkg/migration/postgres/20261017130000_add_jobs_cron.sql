@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN cron TEXT NULL;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN cron;
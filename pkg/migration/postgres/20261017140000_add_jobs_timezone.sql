@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE jobs ALTER COLUMN once TYPE TIMESTAMPTZ USING once AT TIME ZONE 'UTC';
+ALTER TABLE jobs ADD COLUMN timezone TEXT NOT NULL DEFAULT 'UTC';
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN timezone;
+ALTER TABLE jobs ALTER COLUMN once TYPE TIMESTAMP USING once AT TIME ZONE 'UTC';
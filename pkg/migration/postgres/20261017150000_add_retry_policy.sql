@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN retry_policy JSONB;
+ALTER TABLE executions ADD COLUMN attempt INT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE executions DROP COLUMN attempt;
+ALTER TABLE jobs DROP COLUMN retry_policy;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE dead_letter (
+    id TEXT PRIMARY KEY,
+    job_id TEXT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    execution_id TEXT NOT NULL,
+    attempts INT NOT NULL,
+    reason TEXT NULL,
+    output TEXT NULL,
+    created_at BIGINT NOT NULL
+);
+
+CREATE INDEX dead_letter_created_at_idx ON dead_letter (created_at);
+
+-- +goose Down
+DROP TABLE dead_letter;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN timeout_ms BIGINT NULL;
+
+CREATE INDEX executions_started_at_idx ON executions (started_at) WHERE status = 'running';
+
+-- +goose Down
+DROP INDEX executions_started_at_idx;
+ALTER TABLE jobs DROP COLUMN timeout_ms;
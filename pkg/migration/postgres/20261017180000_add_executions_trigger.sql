@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE executions
+    ADD COLUMN trigger TEXT NOT NULL DEFAULT 'schedule',
+    ADD COLUMN payload JSONB NULL;
+
+-- +goose Down
+ALTER TABLE executions
+    DROP COLUMN payload,
+    DROP COLUMN trigger;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE executions
+    DROP CONSTRAINT executions_job_id_fkey,
+    ADD CONSTRAINT executions_job_id_fkey FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE CASCADE;
+
+-- +goose Down
+ALTER TABLE executions
+    DROP CONSTRAINT executions_job_id_fkey,
+    ADD CONSTRAINT executions_job_id_fkey FOREIGN KEY (job_id) REFERENCES jobs(id);
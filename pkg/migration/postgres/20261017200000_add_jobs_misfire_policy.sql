@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN misfire_policy TEXT NOT NULL DEFAULT 'fire_once_now';
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN misfire_policy;
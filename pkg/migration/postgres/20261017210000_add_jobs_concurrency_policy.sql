@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN concurrency_policy TEXT NOT NULL DEFAULT 'forbid';
+CREATE INDEX executions_active_job_id_idx ON executions (job_id) WHERE status IN ('queued', 'running');
+
+-- +goose Down
+DROP INDEX executions_active_job_id_idx;
+ALTER TABLE jobs DROP COLUMN concurrency_policy;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE scheduler_shards (
+shard INT PRIMARY KEY,
+owner TEXT NULL,
+lease_expires_at BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE scheduler_members (
+id TEXT PRIMARY KEY,
+expires_at BIGINT NOT NULL
+);
+
+-- +goose Down
+DROP TABLE scheduler_members;
+DROP TABLE scheduler_shards;
@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE workflows (
+id TEXT PRIMARY KEY,
+name TEXT NOT NULL DEFAULT '',
+created_at BIGINT NOT NULL,
+nodes JSONB NOT NULL
+);
+
+CREATE TABLE workflow_runs (
+id TEXT PRIMARY KEY,
+workflow_id TEXT NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+status TEXT NOT NULL,
+revision INT NOT NULL DEFAULT 0,
+created_at BIGINT NOT NULL,
+finished_at BIGINT NULL
+);
+
+CREATE INDEX workflow_runs_running_idx ON workflow_runs (created_at) WHERE status = 'running';
+
+ALTER TABLE jobs ADD COLUMN workflow_id TEXT NULL REFERENCES workflows(id) ON DELETE CASCADE;
+ALTER TABLE executions ADD COLUMN workflow_run_id TEXT NULL REFERENCES workflow_runs(id) ON DELETE CASCADE;
+CREATE INDEX executions_workflow_run_id_idx ON executions (workflow_run_id) WHERE workflow_run_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX executions_workflow_run_id_idx;
+ALTER TABLE executions DROP COLUMN workflow_run_id;
+ALTER TABLE jobs DROP COLUMN workflow_id;
+DROP TABLE workflow_runs;
+DROP TABLE workflows;
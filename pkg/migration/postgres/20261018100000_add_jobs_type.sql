@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN type TEXT NOT NULL DEFAULT 'worker';
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN type;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE executions ADD COLUMN error TEXT;
+
+CREATE TABLE execution_logs (
+seq BIGSERIAL PRIMARY KEY,
+execution_id TEXT NOT NULL REFERENCES executions(id) ON DELETE CASCADE,
+stream TEXT NOT NULL,
+data TEXT NOT NULL,
+created_at BIGINT NOT NULL
+);
+CREATE INDEX execution_logs_execution_id_seq_idx ON execution_logs (execution_id, seq);
+
+-- +goose Down
+DROP TABLE execution_logs;
+ALTER TABLE executions DROP COLUMN error;
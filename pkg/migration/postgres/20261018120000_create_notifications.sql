@@ -0,0 +1,26 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN notifications JSONB NULL;
+
+CREATE TABLE notifications (
+id TEXT PRIMARY KEY,
+job_id TEXT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+execution_id TEXT NOT NULL,
+event TEXT NOT NULL,
+url TEXT NOT NULL,
+secret TEXT NOT NULL,
+body JSONB NOT NULL,
+status TEXT NOT NULL,
+attempts INT NOT NULL DEFAULT 0,
+last_status_code INT NULL,
+last_error TEXT NULL,
+created_at BIGINT NOT NULL,
+next_attempt_at BIGINT NULL,
+delivered_at BIGINT NULL
+);
+
+CREATE INDEX notifications_job_id_idx ON notifications (job_id, created_at);
+CREATE INDEX notifications_pending_idx ON notifications (next_attempt_at) WHERE status = 'pending';
+
+-- +goose Down
+DROP TABLE notifications;
+ALTER TABLE jobs DROP COLUMN notifications;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Список заданий по умолчанию постранично читается в порядке created_at, id
+CREATE INDEX jobs_created_at_id_idx ON jobs (created_at, id);
+
+-- +goose Down
+DROP INDEX jobs_created_at_id_idx;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- История выполнений задания читается постранично в порядке started_at и фильтруется по нему
+CREATE INDEX executions_job_id_started_at_idx ON executions (job_id, started_at);
+
+-- +goose Down
+DROP INDEX executions_job_id_started_at_idx;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+-- GIN-индекс обслуживает селекторы меток: вхождение (@>) и наличие ключа (?)
+CREATE INDEX jobs_labels_idx ON jobs USING GIN (labels);
+
+-- +goose Down
+DROP INDEX jobs_labels_idx;
+ALTER TABLE jobs DROP COLUMN labels;
@@ -0,0 +1,117 @@
+-- +goose Up
+-- Схема повторяет итог миграций Postgres: JSONB хранится как TEXT с JSON, шарды не нужны одному процессу
+CREATE TABLE workflows (
+id TEXT PRIMARY KEY,
+name TEXT NOT NULL DEFAULT '',
+created_at INTEGER NOT NULL,
+nodes TEXT NOT NULL
+);
+
+CREATE TABLE jobs (
+id TEXT PRIMARY KEY,
+type TEXT NOT NULL DEFAULT 'worker',
+once TEXT NULL,
+interval TEXT NULL,
+cron TEXT NULL,
+timezone TEXT NOT NULL DEFAULT 'UTC',
+status TEXT NOT NULL,
+created_at INTEGER NOT NULL,
+last_finished_at INTEGER NOT NULL DEFAULT 0,
+next_run_at INTEGER NULL,
+payload TEXT NOT NULL,
+retry_policy TEXT NULL,
+timeout_ms INTEGER NULL,
+misfire_policy TEXT NOT NULL DEFAULT 'fire_once_now',
+concurrency_policy TEXT NOT NULL DEFAULT 'forbid',
+workflow_id TEXT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+notifications TEXT NULL
+);
+
+CREATE INDEX jobs_next_run_at_idx ON jobs (next_run_at) WHERE next_run_at IS NOT NULL;
+
+CREATE TABLE workflow_runs (
+id TEXT PRIMARY KEY,
+workflow_id TEXT NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+status TEXT NOT NULL,
+revision INTEGER NOT NULL DEFAULT 0,
+created_at INTEGER NOT NULL,
+finished_at INTEGER NULL
+);
+
+CREATE INDEX workflow_runs_running_idx ON workflow_runs (created_at) WHERE status = 'running';
+
+CREATE TABLE executions (
+id TEXT PRIMARY KEY,
+job_id TEXT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+worker_id TEXT NULL,
+status TEXT NOT NULL,
+attempt INTEGER NOT NULL DEFAULT 1,
+trigger TEXT NOT NULL DEFAULT 'schedule',
+payload TEXT NULL,
+workflow_run_id TEXT NULL REFERENCES workflow_runs(id) ON DELETE CASCADE,
+scheduled_at INTEGER NULL,
+started_at INTEGER NULL,
+finished_at INTEGER NULL,
+heartbeat_at INTEGER NULL,
+lease_expires_at INTEGER NULL,
+output TEXT NULL,
+reason TEXT NULL,
+error TEXT NULL
+);
+
+CREATE INDEX executions_queued_idx ON executions (scheduled_at) WHERE status = 'queued';
+CREATE INDEX executions_lease_expires_at_idx ON executions (lease_expires_at) WHERE status = 'running';
+CREATE INDEX executions_started_at_idx ON executions (started_at) WHERE status = 'running';
+CREATE INDEX executions_active_job_id_idx ON executions (job_id) WHERE status IN ('queued', 'running');
+CREATE INDEX executions_workflow_run_id_idx ON executions (workflow_run_id) WHERE workflow_run_id IS NOT NULL;
+
+CREATE TABLE dead_letter (
+id TEXT PRIMARY KEY,
+job_id TEXT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+execution_id TEXT NOT NULL,
+attempts INTEGER NOT NULL,
+reason TEXT NULL,
+output TEXT NULL,
+created_at INTEGER NOT NULL
+);
+
+CREATE INDEX dead_letter_created_at_idx ON dead_letter (created_at);
+
+CREATE TABLE execution_logs (
+seq INTEGER PRIMARY KEY AUTOINCREMENT,
+execution_id TEXT NOT NULL REFERENCES executions(id) ON DELETE CASCADE,
+stream TEXT NOT NULL,
+data TEXT NOT NULL,
+created_at INTEGER NOT NULL
+);
+
+CREATE INDEX execution_logs_execution_id_seq_idx ON execution_logs (execution_id, seq);
+
+CREATE TABLE notifications (
+id TEXT PRIMARY KEY,
+job_id TEXT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+execution_id TEXT NOT NULL,
+event TEXT NOT NULL,
+url TEXT NOT NULL,
+secret TEXT NOT NULL,
+body TEXT NOT NULL,
+status TEXT NOT NULL,
+attempts INTEGER NOT NULL DEFAULT 0,
+last_status_code INTEGER NULL,
+last_error TEXT NULL,
+created_at INTEGER NOT NULL,
+next_attempt_at INTEGER NULL,
+delivered_at INTEGER NULL
+);
+
+CREATE INDEX notifications_job_id_idx ON notifications (job_id, created_at);
+CREATE INDEX notifications_pending_idx ON notifications (next_attempt_at) WHERE status = 'pending';
+
+-- +goose Down
+DROP TABLE notifications;
+DROP TABLE execution_logs;
+DROP TABLE dead_letter;
+DROP TABLE executions;
+DROP TABLE workflow_runs;
+DROP TABLE jobs;
+DROP TABLE workflows;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Метки хранятся JSON-объектом, селекторы читают его через json_each
+ALTER TABLE jobs ADD COLUMN labels TEXT NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN labels;
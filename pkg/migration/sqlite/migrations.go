@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"github.com/pressly/goose/v3"
+)
+
+//go:embed *.sql
+var embedMigrations embed.FS
+
+func Migrate(db *sql.DB) error {
+	goose.SetBaseFS(embedMigrations)
+
+	goose.SetDialect("sqlite3")
+
+	return goose.Up(db, ".")
+}
@@ -6,3 +6,24 @@ func Deref(s *string) string {
 	}
 	return *s
 }
+
+func DerefInt64(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+func NilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func NilIfZero(i int64) *int64 {
+	if i == 0 {
+		return nil
+	}
+	return &i
+}